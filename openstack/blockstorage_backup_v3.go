@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	blockstorageV3BackupUpdateMicroversion   = "3.9"
+	blockstorageV3BackupMetadataMicroversion = "3.43"
+	blockstorageV3BackupAZMicroversion       = "3.51"
+)
+
+// backupListDetailOpts adds the name, status and volume_id filters, which
+// are accepted by the backups detail API, to backups.ListDetailOpts.
+type backupListDetailOpts struct {
+	backups.ListDetailOpts
+	Name     string
+	Status   string
+	VolumeID string
+}
+
+func (opts backupListDetailOpts) ToBackupListDetailQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts.ListDetailOpts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.Name != "" {
+		params.Add("name", opts.Name)
+	}
+
+	if opts.Status != "" {
+		params.Add("status", opts.Status)
+	}
+
+	if opts.VolumeID != "" {
+		params.Add("volume_id", opts.VolumeID)
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+
+	return q.String(), nil
+}
+
+// backupUpdateOpts wraps backups.UpdateOpts. The metadata is sent even when
+// it's empty, so that all the metadata of a backup can be removed. The body
+// is also nested under the "backup" key, as expected by the backups API.
+type backupUpdateOpts struct {
+	backups.UpdateOpts
+	Metadata *map[string]string
+}
+
+func (opts backupUpdateOpts) ToBackupUpdateMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts.UpdateOpts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Metadata != nil {
+		b["metadata"] = *opts.Metadata
+	}
+
+	return map[string]any{"backup": b}, nil
+}
+
+func blockStorageBackupV3StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, backupID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		b, err := backups.Get(ctx, client, backupID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return b, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if b.Status == "error" || b.Status == "error_deleting" {
+			return b, b.Status, errors.New("The backup is in error status: " + b.FailReason + ". " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		return b, b.Status, nil
+	}
+}
+
+// blockStorageV3BackupSort represents a sortable slice of block storage
+// v3 backups.
+type blockStorageV3BackupSort []backups.Backup
+
+func (backup blockStorageV3BackupSort) Len() int {
+	return len(backup)
+}
+
+func (backup blockStorageV3BackupSort) Swap(i, j int) {
+	backup[i], backup[j] = backup[j], backup[i]
+}
+
+func (backup blockStorageV3BackupSort) Less(i, j int) bool {
+	itime := backup[i].CreatedAt
+	jtime := backup[j].CreatedAt
+
+	return itime.Unix() < jtime.Unix()
+}
+
+func dataSourceBlockStorageV3MostRecentBackup(backups []backups.Backup) backups.Backup {
+	sortedBackups := backups
+	sort.Sort(blockStorageV3BackupSort(sortedBackups))
+
+	return sortedBackups[len(sortedBackups)-1]
+}
+
+func flattenBlockStorageBackupV3Metadata(b *backups.Backup) map[string]string {
+	if b.Metadata == nil {
+		return map[string]string{}
+	}
+
+	return *b.Metadata
+}
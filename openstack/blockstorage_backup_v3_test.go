@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+)
+
+func TestUnitBackupUpdateOptsEmptyMetadata(t *testing.T) {
+	name := "backup_1"
+	opts := backupUpdateOpts{
+		UpdateOpts: backups.UpdateOpts{
+			Name: &name,
+		},
+		Metadata: &map[string]string{},
+	}
+
+	expected := map[string]any{
+		"backup": map[string]any{
+			"name":     "backup_1",
+			"metadata": map[string]string{},
+		},
+	}
+
+	actual, err := opts.ToBackupUpdateMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
+
+func TestUnitBackupUpdateOptsNoMetadata(t *testing.T) {
+	description := ""
+	opts := backupUpdateOpts{
+		UpdateOpts: backups.UpdateOpts{
+			Description: &description,
+		},
+	}
+
+	expected := map[string]any{
+		"backup": map[string]any{
+			"description": "",
+		},
+	}
+
+	actual, err := opts.ToBackupUpdateMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,354 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Generic volume groups are not available in gophercloud yet, so the
+// requests below are issued against the Block Storage API directly.
+const (
+	blockstorageV3GroupTypeMicroversion     = "3.11"
+	blockstorageV3GroupMicroversion         = "3.13"
+	blockstorageV3GroupSnapshotMicroversion = "3.14"
+)
+
+// blockStorageGroupTypeV3 represents a Block Storage group type.
+type blockStorageGroupTypeV3 struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	IsPublic    bool              `json:"is_public"`
+	GroupSpecs  map[string]string `json:"group_specs"`
+}
+
+// blockStorageGroupTypeV3CreateOpts represents the attributes used when
+// creating a new group type.
+type blockStorageGroupTypeV3CreateOpts struct {
+	Name        string            `json:"name" required:"true"`
+	Description string            `json:"description,omitempty"`
+	IsPublic    *bool             `json:"is_public,omitempty"`
+	GroupSpecs  map[string]string `json:"group_specs,omitempty"`
+}
+
+// blockStorageGroupTypeV3UpdateOpts represents the attributes used when
+// updating an existing group type.
+type blockStorageGroupTypeV3UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+}
+
+// blockStorageGroupV3 represents a Block Storage generic volume group.
+type blockStorageGroupV3 struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Status           string   `json:"status"`
+	AvailabilityZone string   `json:"availability_zone"`
+	GroupType        string   `json:"group_type"`
+	VolumeTypes      []string `json:"volume_types"`
+	CreatedAt        string   `json:"created_at"`
+}
+
+// blockStorageGroupV3CreateOpts represents the attributes used when creating
+// a new generic volume group.
+type blockStorageGroupV3CreateOpts struct {
+	Name             string   `json:"name,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	GroupType        string   `json:"group_type" required:"true"`
+	VolumeTypes      []string `json:"volume_types" required:"true"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+}
+
+// blockStorageGroupV3UpdateOpts represents the attributes used when updating
+// an existing generic volume group. AddVolumes and RemoveVolumes are comma
+// separated lists of volume IDs.
+type blockStorageGroupV3UpdateOpts struct {
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	AddVolumes    string  `json:"add_volumes,omitempty"`
+	RemoveVolumes string  `json:"remove_volumes,omitempty"`
+}
+
+// blockStorageGroupSnapshotV3 represents a Block Storage group snapshot.
+type blockStorageGroupSnapshotV3 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	GroupID     string `json:"group_id"`
+	GroupTypeID string `json:"group_type_id"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// blockStorageGroupSnapshotV3CreateOpts represents the attributes used when
+// creating a new group snapshot.
+type blockStorageGroupSnapshotV3CreateOpts struct {
+	GroupID     string `json:"group_id" required:"true"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func blockStorageGroupTypeV3Create(ctx context.Context, client *gophercloud.ServiceClient, opts blockStorageGroupTypeV3CreateOpts) (*blockStorageGroupTypeV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group_type")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		GroupType blockStorageGroupTypeV3 `json:"group_type"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("group_types"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.GroupType, nil
+}
+
+func blockStorageGroupTypeV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*blockStorageGroupTypeV3, error) {
+	var r struct {
+		GroupType blockStorageGroupTypeV3 `json:"group_type"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("group_types", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.GroupType, nil
+}
+
+func blockStorageGroupTypeV3Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts blockStorageGroupTypeV3UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "group_type")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("group_types", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func blockStorageGroupTypeV3Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("group_types", id), nil)
+
+	return err
+}
+
+func blockStorageGroupTypeV3CreateGroupSpecs(ctx context.Context, client *gophercloud.ServiceClient, id string, specs map[string]string) error {
+	b := map[string]any{
+		"group_specs": specs,
+	}
+
+	_, err := client.Post(ctx, client.ServiceURL("group_types", id, "group_specs"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func blockStorageGroupTypeV3DeleteGroupSpec(ctx context.Context, client *gophercloud.ServiceClient, id, key string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("group_types", id, "group_specs", key), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+
+	return err
+}
+
+func blockStorageGroupV3Create(ctx context.Context, client *gophercloud.ServiceClient, opts blockStorageGroupV3CreateOpts) (*blockStorageGroupV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Group blockStorageGroupV3 `json:"group"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("groups"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Group, nil
+}
+
+func blockStorageGroupV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*blockStorageGroupV3, error) {
+	var r struct {
+		Group blockStorageGroupV3 `json:"group"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("groups", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Group, nil
+}
+
+func blockStorageGroupV3Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts blockStorageGroupV3UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "group")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("groups", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func blockStorageGroupV3Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	b := map[string]any{
+		"delete": map[string]any{
+			"delete-volumes": false,
+		},
+	}
+
+	_, err := client.Post(ctx, client.ServiceURL("groups", id, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func blockStorageGroupSnapshotV3Create(ctx context.Context, client *gophercloud.ServiceClient, opts blockStorageGroupSnapshotV3CreateOpts) (*blockStorageGroupSnapshotV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group_snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		GroupSnapshot blockStorageGroupSnapshotV3 `json:"group_snapshot"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("group_snapshots"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.GroupSnapshot, nil
+}
+
+func blockStorageGroupSnapshotV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*blockStorageGroupSnapshotV3, error) {
+	var r struct {
+		GroupSnapshot blockStorageGroupSnapshotV3 `json:"group_snapshot"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("group_snapshots", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.GroupSnapshot, nil
+}
+
+func blockStorageGroupSnapshotV3Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("group_snapshots", id), nil)
+
+	return err
+}
+
+// blockStorageVolumeV3GroupID returns the ID of the generic volume group
+// the volume belongs to. The group_id attribute is only returned starting
+// with microversion 3.13.
+func blockStorageVolumeV3GroupID(ctx context.Context, client *gophercloud.ServiceClient, volumeID string) (string, error) {
+	var r struct {
+		Volume struct {
+			GroupID *string `json:"group_id"`
+		} `json:"volume"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("volumes", volumeID), &r, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if r.Volume.GroupID == nil {
+		return "", nil
+	}
+
+	return *r.Volume.GroupID, nil
+}
+
+// blockStorageGroupV3UpdateVolumes adds the volume to the group newID and
+// removes it from the group oldID. Empty IDs are skipped.
+func blockStorageGroupV3UpdateVolumes(ctx context.Context, client *gophercloud.ServiceClient, volumeID, oldID, newID string) error {
+	if oldID != "" {
+		err := blockStorageGroupV3Update(ctx, client, oldID, blockStorageGroupV3UpdateOpts{
+			RemoveVolumes: volumeID,
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return err
+		}
+	}
+
+	if newID != "" {
+		err := blockStorageGroupV3Update(ctx, client, newID, blockStorageGroupV3UpdateOpts{
+			AddVolumes: volumeID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func blockStorageGroupV3StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, groupID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		g, err := blockStorageGroupV3Get(ctx, client, groupID)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return g, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if g.Status == "error" || g.Status == "error_deleting" {
+			return g, g.Status, fmt.Errorf("The group is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", g.Status)
+		}
+
+		return g, g.Status, nil
+	}
+}
+
+func blockStorageGroupSnapshotV3StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, groupSnapshotID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		s, err := blockStorageGroupSnapshotV3Get(ctx, client, groupSnapshotID)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return s, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The group snapshot is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
@@ -1,9 +1,14 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"sort"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 // blockStorageV3SnapshotSort represents a sortable slice of block storage
@@ -31,3 +36,24 @@ func dataSourceBlockStorageV3MostRecentSnapshot(snapshots []snapshots.Snapshot)
 
 	return sortedSnapshots[len(sortedSnapshots)-1]
 }
+
+func blockStorageSnapshotV3StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, snapshotID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		s, err := snapshots.Get(ctx, client, snapshotID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return s, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The snapshot is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
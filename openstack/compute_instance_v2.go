@@ -11,14 +11,17 @@ package openstack
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,8 +33,58 @@ const (
 	computeV2InstanceBlockDeviceVolumeTypeMicroversion              = "2.67"
 	computeV2InstanceBlockDeviceVolumeAttachTagsMicroversion        = "2.49"
 	computeV2InstanceBlockDeviceMultiattachMicroversion             = "2.60"
+	computeV2InstanceUnshelveAvailabilityZoneMicroversion           = "2.77"
+	computeV2InstanceRebuildWithKeyPairAndUserDataMicroversion      = "2.57"
 )
 
+// ComputeInstanceRebuildOpts represents the attributes used when rebuilding
+// an instance. It adds the key_name (microversion 2.54) and user_data
+// (microversion 2.57) fields, which servers.RebuildOpts lacks.
+type ComputeInstanceRebuildOpts struct {
+	servers.RebuildOpts
+	KeyName  *string
+	UserData *string
+}
+
+// ToServerRebuildMap casts a ComputeInstanceRebuildOpts struct to a map.
+// It overrides servers.ToServerRebuildMap to add the KeyName and UserData
+// fields. An empty value removes the key pair or user data of the instance.
+func (opts ComputeInstanceRebuildOpts) ToServerRebuildMap() (map[string]any, error) {
+	b, err := opts.RebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		return nil, err
+	}
+
+	rebuild, ok := b["rebuild"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("Expected map but got %T", b["rebuild"])
+	}
+
+	if opts.KeyName != nil {
+		if *opts.KeyName == "" {
+			rebuild["key_name"] = nil
+		} else {
+			rebuild["key_name"] = *opts.KeyName
+		}
+	}
+
+	if opts.UserData != nil {
+		if *opts.UserData == "" {
+			rebuild["user_data"] = nil
+		} else {
+			// Encode the user data the same way servers.Create does.
+			userData := *opts.UserData
+			if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+				userData = base64.StdEncoding.EncodeToString([]byte(userData))
+			}
+
+			rebuild["user_data"] = userData
+		}
+	}
+
+	return b, nil
+}
+
 // InstanceNIC is a structured representation of a Gophercloud servers.Server
 // virtual NIC.
 type InstanceNIC struct {
@@ -495,3 +548,154 @@ func computeV2InstanceUpdateTags(d *schema.ResourceData) []string {
 func computeV2InstanceTags(d *schema.ResourceData) []string {
 	return expandObjectTags(d)
 }
+
+// computeV2InstanceShelve shelves an instance and waits until it is either
+// shelved or shelved and offloaded. Nova may offload a shelved instance on its
+// own, depending on its shelved_offload_time setting. When offload is set, an
+// instance which is still only shelved is offloaded explicitly.
+func computeV2InstanceShelve(ctx context.Context, client *gophercloud.ServiceClient, id string, offload bool, timeout time.Duration) error {
+	err := servers.Shelve(ctx, client, id).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error shelving OpenStack instance: %w", err)
+	}
+
+	shelveStateConf := &retry.StateChangeConf{
+		Target:     []string{"SHELVED", "SHELVED_OFFLOADED"},
+		Refresh:    ServerV2StateRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to shelve", id)
+
+	s, err := shelveStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become shelved: %w", id, err)
+	}
+
+	if !offload || s.(*servers.Server).Status == "SHELVED_OFFLOADED" {
+		return nil
+	}
+
+	return computeV2InstanceShelveOffload(ctx, client, id, timeout)
+}
+
+// computeV2InstanceShelveOffload offloads a shelved instance and waits until
+// it is shelved and offloaded.
+func computeV2InstanceShelveOffload(ctx context.Context, client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	err := servers.ShelveOffload(ctx, client, id).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error offloading OpenStack instance: %w", err)
+	}
+
+	offloadStateConf := &retry.StateChangeConf{
+		Pending:    []string{"SHELVED"},
+		Target:     []string{"SHELVED_OFFLOADED"},
+		Refresh:    ServerV2StateRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to offload", id)
+
+	_, err = offloadStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become shelved_offloaded: %w", id, err)
+	}
+
+	return nil
+}
+
+// computeV2InstanceUnshelve unshelves an instance and waits until it is
+// active. A target availability zone can only be passed on microversion 2.77
+// and later, so the request is left empty otherwise.
+func computeV2InstanceUnshelve(ctx context.Context, client *gophercloud.ServiceClient, id, availabilityZone string, timeout time.Duration) error {
+	unshelveOpts := servers.UnshelveOpts{}
+
+	if availabilityZone != "" {
+		bumpClientMicroversion(client, computeV2InstanceUnshelveAvailabilityZoneMicroversion)
+
+		unshelveOpts.AvailabilityZone = availabilityZone
+	}
+
+	err := servers.Unshelve(ctx, client, id, unshelveOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error unshelving OpenStack instance: %w", err)
+	}
+
+	unshelveStateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    ServerV2StateRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to unshelve", id)
+
+	_, err = unshelveStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become active: %w", id, err)
+	}
+
+	return nil
+}
+
+// computeV2InstanceRescue puts an instance into rescue mode, optionally
+// booting it from the given rescue image, and waits until it is rescued.
+func computeV2InstanceRescue(ctx context.Context, client *gophercloud.ServiceClient, id, imageID string, timeout time.Duration) error {
+	rescueOpts := servers.RescueOpts{
+		RescueImageRef: imageID,
+	}
+
+	_, err := servers.Rescue(ctx, client, id, rescueOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error rescuing OpenStack instance: %w", err)
+	}
+
+	rescueStateConf := &retry.StateChangeConf{
+		Target:     []string{"RESCUE"},
+		Refresh:    ServerV2StateRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to rescue", id)
+
+	_, err = rescueStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become rescued: %w", id, err)
+	}
+
+	return nil
+}
+
+// computeV2InstanceUnrescue takes an instance out of rescue mode and waits
+// until it is active.
+func computeV2InstanceUnrescue(ctx context.Context, client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	err := servers.Unrescue(ctx, client, id).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error unrescuing OpenStack instance: %w", err)
+	}
+
+	unrescueStateConf := &retry.StateChangeConf{
+		Pending:    []string{"RESCUE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    ServerV2StateRefreshFunc(ctx, client, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to unrescue", id)
+
+	_, err = unrescueStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become active: %w", id, err)
+	}
+
+	return nil
+}
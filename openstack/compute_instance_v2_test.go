@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+)
+
+func TestUnitComputeInstanceRebuildOpts(t *testing.T) {
+	keyName := "kp_1"
+	userData := ""
+
+	rebuildOpts := ComputeInstanceRebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageRef: "image_1",
+			Metadata: map[string]string{
+				"foo": "bar",
+			},
+		},
+		KeyName:  &keyName,
+		UserData: &userData,
+	}
+
+	expected := map[string]any{
+		"rebuild": map[string]any{
+			"imageRef": "image_1",
+			"metadata": map[string]any{
+				"foo": "bar",
+			},
+			"key_name":  "kp_1",
+			"user_data": nil,
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Maps differ. Want: %#v, but got: %#v", expected, actual)
+	}
+}
+
+func TestUnitComputeInstanceRebuildOptsUserData(t *testing.T) {
+	userData := "#cloud-config"
+
+	rebuildOpts := ComputeInstanceRebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageRef: "image_1",
+		},
+		UserData: &userData,
+	}
+
+	expected := map[string]any{
+		"rebuild": map[string]any{
+			"imageRef":  "image_1",
+			"user_data": "I2Nsb3VkLWNvbmZpZw==",
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Maps differ. Want: %#v, but got: %#v", expected, actual)
+	}
+}
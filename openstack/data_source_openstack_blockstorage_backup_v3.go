@@ -0,0 +1,168 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBlockStorageBackupV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"is_incremental": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageBackupV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// Backup metadata is only returned starting with microversion 3.43.
+	client.Microversion = blockstorageV3BackupMetadataMicroversion
+
+	listOpts := backupListDetailOpts{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		VolumeID: d.Get("volume_id").(string),
+	}
+
+	allPages, err := backups.ListDetail(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	if len(allBackups) < 1 {
+		return diag.Errorf("Your openstack_blockstorage_backup_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	var backup backups.Backup
+
+	if len(allBackups) > 1 {
+		recent := d.Get("most_recent").(bool)
+
+		if recent {
+			backup = dataSourceBlockStorageV3MostRecentBackup(allBackups)
+		} else {
+			log.Printf("[DEBUG] Multiple openstack_blockstorage_backup_v3 results found: %#v", allBackups)
+
+			return diag.Errorf("Your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` attribute to true.")
+		}
+	} else {
+		backup = allBackups[0]
+	}
+
+	dataSourceBlockStorageBackupV3Attributes(d, backup)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceBlockStorageBackupV3Attributes(d *schema.ResourceData, backup backups.Backup) {
+	d.SetId(backup.ID)
+	d.Set("name", backup.Name)
+	d.Set("description", backup.Description)
+	d.Set("status", backup.Status)
+	d.Set("volume_id", backup.VolumeID)
+	d.Set("snapshot_id", backup.SnapshotID)
+	d.Set("container", backup.Container)
+	d.Set("size", backup.Size)
+	d.Set("object_count", backup.ObjectCount)
+	d.Set("is_incremental", backup.IsIncremental)
+	d.Set("has_dependent_backups", backup.HasDependentBackups)
+	d.Set("created_at", backup.CreatedAt.Format(time.RFC3339))
+
+	if err := d.Set("metadata", flattenBlockStorageBackupV3Metadata(&backup)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for backup %s: %s", backup.ID, err)
+	}
+}
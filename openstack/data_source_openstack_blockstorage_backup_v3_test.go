@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageV3BackupDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			t.Skip("Currently Cinder Backup is not configured properly on GH-A devstack")
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic,
+			},
+			{
+				Config: testAccBlockStorageV3BackupDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_backup_v3.backup_1", "id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_backup_v3.backup_1", "name", "backup_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3BackupDataSourceVolume(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_backup_v3.backup_1", "id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3BackupDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_backup_v3" "backup_1" {
+  name = openstack_blockstorage_backup_v3.backup_1.name
+}
+`, testAccBlockStorageV3BackupBasic)
+}
+
+func testAccBlockStorageV3BackupDataSourceVolume() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_backup_v3" "backup_1" {
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  most_recent = true
+
+  depends_on = [openstack_blockstorage_backup_v3.backup_1]
+}
+`, testAccBlockStorageV3BackupBasic)
+}
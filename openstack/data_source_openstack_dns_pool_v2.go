@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSPoolV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSPoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pool_id"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ns_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSPoolV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if id := d.Get("pool_id").(string); id != "" {
+		pool, err := dnsPoolV2Get(ctx, dnsClient, id)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("No DNS pool found")
+			}
+
+			return diag.Errorf("Unable to retrieve OpenStack %s DNS pool: %s", id, err)
+		}
+
+		dataSourceDNSPoolV2Attributes(d, pool)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	allPools, err := dnsPoolV2List(ctx, dnsClient)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve OpenStack DNS pools: %s", err)
+	}
+
+	// The pools API does not support filtering, so do it here.
+	var pools []dnsPoolV2
+
+	name := d.Get("name").(string)
+	for _, pool := range allPools {
+		if name != "" && pool.Name != name {
+			continue
+		}
+
+		pools = append(pools, pool)
+	}
+
+	if len(pools) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(pools) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", pools)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceDNSPoolV2Attributes(d, &pools[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceDNSPoolV2Attributes(d *schema.ResourceData, pool *dnsPoolV2) {
+	log.Printf("[DEBUG] Retrieved openstack_dns_pool_v2 %s: %#v", pool.ID, pool)
+
+	d.SetId(pool.ID)
+	d.Set("pool_id", pool.ID)
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("project_id", pool.ProjectID)
+	d.Set("attributes", pool.Attributes)
+	d.Set("ns_records", flattenDNSPoolV2NSRecords(pool.NSRecords))
+	d.Set("created_at", pool.CreatedAt)
+	d.Set("updated_at", pool.UpdatedAt)
+}
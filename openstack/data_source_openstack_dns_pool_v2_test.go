@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2PoolDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackDNSPoolV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_dns_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_pool_v2.pool_1", "name", "default"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_dns_pool_v2.pool_1", "ns_records.0.hostname"),
+				),
+			},
+			{
+				Config: testAccOpenStackDNSPoolV2DataSourceID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_pool_v2.pool_2", "id",
+						"data.openstack_dns_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_pool_v2.pool_2", "name", "default"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackDNSPoolV2DataSourceBasic = `
+data "openstack_dns_pool_v2" "pool_1" {
+  name = "default"
+}
+`
+
+const testAccOpenStackDNSPoolV2DataSourceID = `
+data "openstack_dns_pool_v2" "pool_1" {
+  name = "default"
+}
+
+data "openstack_dns_pool_v2" "pool_2" {
+  pool_id = data.openstack_dns_pool_v2.pool_1.id
+}
+`
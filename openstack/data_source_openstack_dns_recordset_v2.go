@@ -0,0 +1,179 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDNSRecordSetV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordSetV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"recordset_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordSetV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+
+	if id := d.Get("recordset_id").(string); id != "" {
+		recordset, err := recordsets.Get(ctx, dnsClient, zoneID, id).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("No DNS recordset found")
+			}
+
+			return diag.Errorf("Unable to retrieve OpenStack %s DNS recordset: %s", id, err)
+		}
+
+		dataSourceDNSRecordSetV2Attributes(d, recordset)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	listOpts := recordsets.ListOpts{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+	}
+
+	pages, err := recordsets.ListByZone(dnsClient, zoneID, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_dns_recordset_v2: %s", err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_recordset_v2: %s", err)
+	}
+
+	if len(allRecordSets) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRecordSets) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allRecordSets)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceDNSRecordSetV2Attributes(d, &allRecordSets[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceDNSRecordSetV2Attributes(d *schema.ResourceData, recordset *recordsets.RecordSet) {
+	log.Printf("[DEBUG] Retrieved openstack_dns_recordset_v2 %s: %#v", recordset.ID, recordset)
+
+	d.SetId(recordset.ID)
+	d.Set("recordset_id", recordset.ID)
+	d.Set("zone_id", recordset.ZoneID)
+	d.Set("zone_name", recordset.ZoneName)
+	d.Set("name", recordset.Name)
+	d.Set("type", recordset.Type)
+	d.Set("project_id", recordset.ProjectID)
+	d.Set("records", recordset.Records)
+	d.Set("ttl", recordset.TTL)
+	d.Set("description", recordset.Description)
+	d.Set("status", recordset.Status)
+	d.Set("version", recordset.Version)
+	d.Set("created_at", recordset.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", recordset.UpdatedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,54 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2RecordSetDataSource_basic(t *testing.T) {
+	zoneName := zoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSetBasic(zoneName),
+			},
+			{
+				Config: testAccOpenStackDNSRecordSetV2DataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_recordset_v2.rs_1", "id",
+						"openstack_dns_recordset_v2.recordset_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "name", zoneName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "type", "A"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "ttl", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs_1", "records.0", "10.1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSRecordSetV2DataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_dns_recordset_v2" "rs_1" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+  name    = openstack_dns_recordset_v2.recordset_1.name
+  type    = "A"
+}
+`, testAccDNSV2RecordSetBasic(zoneName))
+}
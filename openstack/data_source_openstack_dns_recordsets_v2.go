@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceDNSRecordSetsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordSetsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"all_projects": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"recordsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordSetsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	// Designate supports "*" wildcards in the name and data filters.
+	listOpts := recordsets.ListOpts{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		Data:        d.Get("data").(string),
+		Description: d.Get("description").(string),
+		Status:      d.Get("status").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	pages, err := recordsets.ListByZone(dnsClient, d.Get("zone_id").(string), listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_dns_recordsets_v2: %s", err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_recordsets_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d recordsets in openstack_dns_recordsets_v2: %+v", len(allRecordSets), allRecordSets)
+
+	ids := make([]string, 0, len(allRecordSets))
+	for _, r := range allRecordSets {
+		ids = append(ids, r.ID)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ""))))
+	d.Set("recordsets", flattenDNSRecordSetsV2(allRecordSets))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
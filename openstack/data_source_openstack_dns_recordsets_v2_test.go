@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2RecordSetsDataSource_basic(t *testing.T) {
+	zoneName := zoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSetBasic(zoneName),
+			},
+			{
+				Config: testAccOpenStackDNSRecordSetsV2DataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordsets_v2.all", "recordsets.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_recordsets_v2.all", "recordsets.0.id",
+						"openstack_dns_recordset_v2.recordset_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordsets_v2.all", "recordsets.0.records.0", "10.1.0.0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordsets_v2.wildcard", "recordsets.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordsets_v2.none", "recordsets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSRecordSetsV2DataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_dns_recordsets_v2" "all" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+  type    = "A"
+
+  depends_on = [openstack_dns_recordset_v2.recordset_1]
+}
+
+data "openstack_dns_recordsets_v2" "wildcard" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+  name    = "*${openstack_dns_zone_v2.zone_1.name}"
+  type    = "A"
+
+  depends_on = [openstack_dns_recordset_v2.recordset_1]
+}
+
+data "openstack_dns_recordsets_v2" "none" {
+  zone_id = openstack_dns_zone_v2.zone_1.id
+  type    = "AAAA"
+
+  depends_on = [openstack_dns_recordset_v2.recordset_1]
+}
+`, testAccDNSV2RecordSetBasic(zoneName))
+}
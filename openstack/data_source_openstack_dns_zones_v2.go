@@ -0,0 +1,180 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-provider-openstack/utils/v2/hashcode"
+)
+
+func dataSourceDNSZonesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZonesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"all_projects": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"serial": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"masters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSZonesV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(ctx, d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	// Designate supports "*" wildcards in the name filter.
+	listOpts := zones.ListOpts{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		Email:       d.Get("email").(string),
+		Description: d.Get("description").(string),
+		Status:      d.Get("status").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	pages, err := zones.List(dnsClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query openstack_dns_zones_v2: %s", err)
+	}
+
+	allZones, err := zones.ExtractZones(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_dns_zones_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d zones in openstack_dns_zones_v2: %+v", len(allZones), allZones)
+
+	ids := make([]string, 0, len(allZones))
+	for _, z := range allZones {
+		ids = append(ids, z.ID)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ""))))
+	d.Set("zones", flattenDNSZonesV2(allZones))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSV2ZonesDataSource_basic(t *testing.T) {
+	zoneName := zoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackDNSZoneV2DataSourceZone(zoneName),
+			},
+			{
+				Config: testAccOpenStackDNSZonesV2DataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_zones_v2.exact", "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_zones_v2.exact", "zones.0.id",
+						"openstack_dns_zone_v2.z1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_zones_v2.exact", "zones.0.ttl", "7200"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_zones_v2.wildcard", "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_zones_v2.wildcard", "zones.0.name",
+						"openstack_dns_zone_v2.z1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSZonesV2DataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_dns_zones_v2" "exact" {
+  name = openstack_dns_zone_v2.z1.name
+}
+
+data "openstack_dns_zones_v2" "wildcard" {
+  name = "*${substr(openstack_dns_zone_v2.z1.name, 8, -1)}"
+  type = "PRIMARY"
+}
+`, testAccOpenStackDNSZoneV2DataSourceZone(zoneName))
+}
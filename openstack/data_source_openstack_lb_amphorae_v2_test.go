@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2AmphoraeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2AmphoraeDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.loadbalancer_id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.compute_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.lb_network_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2AmphoraeDataSourceBasic() string {
+	return testAccLbV2LoadBalancerConfigFailover("") + `
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
+}
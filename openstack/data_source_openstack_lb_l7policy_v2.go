@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBL7PolicyV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redirect_http_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBL7PolicyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	listOpts := l7policies.ListOpts{
+		ID:         d.Get("l7policy_id").(string),
+		Name:       d.Get("name").(string),
+		ListenerID: d.Get("listener_id").(string),
+		Action:     d.Get("action").(string),
+		Position:   int32(d.Get("position").(int)),
+	}
+
+	allPages, err := l7policies.List(lbClient, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query OpenStack loadbalancer L7 policies: %s", err)
+	}
+
+	allL7Policies, err := l7policies.ExtractL7Policies(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve Openstack loadbalancer L7 policies: %s", err)
+	}
+
+	requestedTags := expandTagsList(d, "tags")
+	if len(requestedTags) > 0 {
+		var filtered []l7policies.L7Policy
+
+		for _, p := range allL7Policies {
+			if hasAllRequestedTags(p.Tags, requestedTags) {
+				filtered = append(filtered, p)
+			}
+		}
+
+		allL7Policies = filtered
+	}
+
+	if len(allL7Policies) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allL7Policies) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allL7Policies)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceLBL7PolicyV2Attributes(d, &allL7Policies[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceLBL7PolicyV2Attributes(d *schema.ResourceData, l7policy *l7policies.L7Policy) {
+	log.Printf("[DEBUG] Retrieved openstack_lb_l7policy_v2 %s: %#v", l7policy.ID, l7policy)
+
+	d.SetId(l7policy.ID)
+	d.Set("l7policy_id", l7policy.ID)
+	d.Set("name", l7policy.Name)
+	d.Set("listener_id", l7policy.ListenerID)
+	d.Set("action", l7policy.Action)
+	d.Set("position", l7policy.Position)
+	d.Set("tags", l7policy.Tags)
+	d.Set("description", l7policy.Description)
+	d.Set("project_id", l7policy.ProjectID)
+	d.Set("redirect_pool_id", l7policy.RedirectPoolID)
+	d.Set("redirect_prefix", l7policy.RedirectPrefix)
+	d.Set("redirect_url", l7policy.RedirectURL)
+	d.Set("redirect_http_code", l7policy.RedirectHttpCode)
+	d.Set("admin_state_up", l7policy.AdminStateUp)
+	d.Set("provisioning_status", l7policy.ProvisioningStatus)
+	d.Set("operating_status", l7policy.OperatingStatus)
+	d.Set("rules", flattenLBL7RulesV2(l7policy.Rules))
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2L7PolicyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7PolicyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7RuleConfigBasic(),
+			},
+			{
+				Config: testAccLBV2L7PolicyDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "id",
+						"openstack_lb_l7policy_v2.l7policy_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "name", "test"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "position", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "redirect_url", "http://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7policy_v2.l7policy_1", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2L7PolicyDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_l7policy_v2" "l7policy_1" {
+  listener_id = openstack_lb_listener_v2.listener_1.id
+  action      = "REDIRECT_TO_URL"
+  position    = 1
+  name        = openstack_lb_l7policy_v2.l7policy_1.name
+
+  depends_on = [openstack_lb_l7rule_v2.l7rule_1]
+}
+`, testAccCheckLbV2L7RuleConfigBasic())
+}
@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBL7RuleV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"l7rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"compare_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"invert": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBL7RuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	listOpts := l7policies.ListRulesOpts{
+		ID:          d.Get("l7rule_id").(string),
+		RuleType:    l7policies.RuleType(d.Get("type").(string)),
+		CompareType: l7policies.CompareType(d.Get("compare_type").(string)),
+		Value:       d.Get("value").(string),
+		Key:         d.Get("key").(string),
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+
+	allPages, err := l7policies.ListRules(lbClient, l7policyID, listOpts).AllPages(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to query OpenStack loadbalancer L7 rules: %s", err)
+	}
+
+	allRules, err := l7policies.ExtractRules(allPages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve Openstack loadbalancer L7 rules: %s", err)
+	}
+
+	requestedTags := expandTagsList(d, "tags")
+	if len(requestedTags) > 0 {
+		var filtered []l7policies.Rule
+
+		for _, r := range allRules {
+			if hasAllRequestedTags(r.Tags, requestedTags) {
+				filtered = append(filtered, r)
+			}
+		}
+
+		allRules = filtered
+	}
+
+	if len(allRules) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRules) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allRules)
+
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	dataSourceLBL7RuleV2Attributes(d, &allRules[0])
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func dataSourceLBL7RuleV2Attributes(d *schema.ResourceData, rule *l7policies.Rule) {
+	log.Printf("[DEBUG] Retrieved openstack_lb_l7rule_v2 %s: %#v", rule.ID, rule)
+
+	d.SetId(rule.ID)
+	d.Set("l7rule_id", rule.ID)
+	d.Set("type", rule.RuleType)
+	d.Set("compare_type", rule.CompareType)
+	d.Set("value", rule.Value)
+	d.Set("key", rule.Key)
+	d.Set("tags", rule.Tags)
+	d.Set("invert", rule.Invert)
+	d.Set("project_id", rule.ProjectID)
+	d.Set("admin_state_up", rule.AdminStateUp)
+	d.Set("provisioning_status", rule.ProvisioningStatus)
+	d.Set("operating_status", rule.OperatingStatus)
+}
@@ -0,0 +1,54 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2L7RuleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2L7RuleDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7RuleConfigBasic(),
+			},
+			{
+				Config: testAccLBV2L7RuleDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_l7rule_v2.l7rule_1", "id",
+						"openstack_lb_l7rule_v2.l7rule_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7rule_v2.l7rule_1", "type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7rule_v2.l7rule_1", "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7rule_v2.l7rule_1", "value", "/api"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_l7rule_v2.l7rule_1", "invert", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2L7RuleDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id  = openstack_lb_l7policy_v2.l7policy_1.id
+  type         = "PATH"
+  compare_type = "EQUAL_TO"
+  value        = openstack_lb_l7rule_v2.l7rule_1.value
+}
+`, testAccCheckLbV2L7RuleConfigBasic())
+}
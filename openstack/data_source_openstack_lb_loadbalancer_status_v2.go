@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBLoadbalancerStatusV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBLoadbalancerStatusV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioning_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pools": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"provisioning_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operating_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"healthmonitor": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"provisioning_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"operating_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"members": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"address": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"protocol_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"provisioning_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"operating_status": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadbalancerStatusV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+
+	statuses, err := loadbalancers.GetStatuses(ctx, lbClient, lbID).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("No loadbalancer found")
+		}
+
+		return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer status tree: %s", lbID, err)
+	}
+
+	if statuses.Loadbalancer == nil {
+		return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer status tree: empty response", lbID)
+	}
+
+	lb := statuses.Loadbalancer
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_status_v2 %s: %#v", lbID, lb)
+
+	d.SetId(lbID)
+	d.Set("name", lb.Name)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("listeners", flattenLBStatusTreeListenersV2(lb.Listeners))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
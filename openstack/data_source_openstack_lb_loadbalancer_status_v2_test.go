@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerStatusDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLbV2MemberConfigBasic,
+			},
+			{
+				Config: testAccLBV2LoadBalancerStatusDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_status_v2.status_1", "listeners.0.pools.0.members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2LoadBalancerStatusDataSourceBasic() string {
+	return TestAccLbV2MemberConfigBasic + `
+data "openstack_lb_loadbalancer_status_v2" "status_1" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+`
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBStatsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBStatsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"listener_id"},
+			},
+
+			"listener_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"loadbalancer_id"},
+			},
+
+			"active_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_out": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"request_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBStatsV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	lbClient, err := config.LoadBalancerV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack loadbalancer client: %s", err)
+	}
+
+	if id := d.Get("loadbalancer_id").(string); id != "" {
+		stats, err := loadbalancers.GetStats(ctx, lbClient, id).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return diag.Errorf("No loadbalancer found")
+			}
+
+			return diag.Errorf("Unable to retrieve OpenStack %s loadbalancer stats: %s", id, err)
+		}
+
+		log.Printf("[DEBUG] Retrieved openstack_lb_stats_v2 for loadbalancer %s: %#v", id, stats)
+
+		d.SetId(id)
+		d.Set("active_connections", stats.ActiveConnections)
+		d.Set("bytes_in", stats.BytesIn)
+		d.Set("bytes_out", stats.BytesOut)
+		d.Set("request_errors", stats.RequestErrors)
+		d.Set("total_connections", stats.TotalConnections)
+		d.Set("region", GetRegion(d, config))
+
+		return nil
+	}
+
+	id := d.Get("listener_id").(string)
+
+	stats, err := listeners.GetStats(ctx, lbClient, id).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return diag.Errorf("No listener found")
+		}
+
+		return diag.Errorf("Unable to retrieve OpenStack %s listener stats: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_stats_v2 for listener %s: %#v", id, stats)
+
+	d.SetId(id)
+	d.Set("active_connections", stats.ActiveConnections)
+	d.Set("bytes_in", stats.BytesIn)
+	d.Set("bytes_out", stats.BytesOut)
+	d.Set("request_errors", stats.RequestErrors)
+	d.Set("total_connections", stats.TotalConnections)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLBV2StatsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLBV2LoadBalancerDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLbV2MemberConfigBasic,
+			},
+			{
+				Config: testAccLBV2StatsDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_stats_v2.lb_stats", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.lb_stats", "total_connections"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_stats_v2.listener_stats", "id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_stats_v2.listener_stats", "bytes_in"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2StatsDataSourceBasic() string {
+	return TestAccLbV2MemberConfigBasic + `
+data "openstack_lb_stats_v2" "lb_stats" {
+  loadbalancer_id = openstack_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+data "openstack_lb_stats_v2" "listener_stats" {
+  listener_id = openstack_lb_listener_v2.listener_1.id
+}
+`
+}
@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkingV2LoggableResourcesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckNetworkingLog(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LoggableResourcesDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_networking_loggable_resources_v2.types", "types.#", regexp.MustCompile(`[1-9]\d*`)),
+					resource.TestCheckTypeSetElemAttr(
+						"data.openstack_networking_loggable_resources_v2.types", "types.*", "security_group"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2LoggableResourcesDataSource = `
+data "openstack_networking_loggable_resources_v2" "types" {}
+`
@@ -0,0 +1,94 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2QoSMinimumPacketRateRuleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSMinimumPacketRateRuleDataSource,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceMinKpps(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSMinimumPacketRateRuleV2DataSourceID("data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "min_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "direction", "any"),
+				),
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceDirection(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSMinimumPacketRateRuleV2DataSourceID("data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "min_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "direction", "any"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSMinimumPacketRateRuleV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find QoS minimum packet rate data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("QoS minimum packet rate data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSMinimumPacketRateRuleDataSource = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = openstack_networking_qos_policy_v2.qos_policy_1.id
+  min_kpps      = 3000
+  direction     = "any"
+}
+`
+
+func testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceMinKpps() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = openstack_networking_qos_policy_v2.qos_policy_1.id
+  min_kpps      = openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1.min_kpps
+}
+`, testAccNetworkingV2QoSMinimumPacketRateRuleDataSource)
+}
+
+func testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceDirection() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = openstack_networking_qos_policy_v2.qos_policy_1.id
+  direction     = openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1.direction
+}
+`, testAccNetworkingV2QoSMinimumPacketRateRuleDataSource)
+}
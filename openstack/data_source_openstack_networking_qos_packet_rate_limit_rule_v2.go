@@ -0,0 +1,104 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkingQoSPacketRateLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingQoSPacketRateLimitRuleV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"max_kpps": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+
+			"max_burst_kpps": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingQoSPacketRateLimitRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := networkingQoSPacketRateLimitRuleV2ListOpts{}
+
+	if v, ok := d.GetOk("max_kpps"); ok {
+		listOpts.MaxKpps = v.(int)
+	}
+
+	if v, ok := d.GetOk("max_burst_kpps"); ok {
+		listOpts.MaxBurstKpps = v.(int)
+	}
+
+	if v, ok := d.GetOk("direction"); ok {
+		listOpts.Direction = v.(string)
+	}
+
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	allRules, err := networkingQoSPacketRateLimitRuleV2List(ctx, networkingClient, qosPolicyID, listOpts)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_qos_packet_rate_limit_rule_v2: %s", err)
+	}
+
+	if len(allRules) < 1 {
+		return diag.Errorf("Your query returned no openstack_networking_qos_packet_rate_limit_rule_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRules) > 1 {
+		return diag.Errorf("Your query returned more than one openstack_networking_qos_packet_rate_limit_rule_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	rule := allRules[0]
+	id := resourceNetworkingQoSRuleV2BuildID(qosPolicyID, rule.ID)
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_qos_packet_rate_limit_rule_v2 %s: %+v", id, rule)
+	d.SetId(id)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("max_kpps", rule.MaxKpps)
+	d.Set("max_burst_kpps", rule.MaxBurstKpps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Designate blacklists are not available in gophercloud yet, so the requests
+// below are issued against the DNS API directly.
+
+// dnsBlacklistV2 represents a Designate blacklist.
+type dnsBlacklistV2 struct {
+	ID          string `json:"id"`
+	Pattern     string `json:"pattern"`
+	Description string `json:"description"`
+}
+
+// dnsBlacklistV2Opts represents the attributes used when creating or updating
+// a blacklist.
+type dnsBlacklistV2Opts struct {
+	Pattern     string  `json:"pattern,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func dnsBlacklistV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts dnsBlacklistV2Opts) (*dnsBlacklistV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsBlacklistV2
+
+	_, err = client.Post(ctx, client.ServiceURL("blacklists"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsBlacklistV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsBlacklistV2, error) {
+	var r dnsBlacklistV2
+
+	_, err := client.Get(ctx, client.ServiceURL("blacklists", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsBlacklistV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsBlacklistV2Opts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(ctx, client.ServiceURL("blacklists", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func dnsBlacklistV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("blacklists", id), nil)
+
+	return err
+}
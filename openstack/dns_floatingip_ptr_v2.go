@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Designate floating IP PTR records are not available in gophercloud yet, so
+// the requests below are issued against the DNS API directly.
+
+// dnsFloatingIPPTRV2 represents the PTR record of a floating IP. Its ID has
+// the "<region>:<floatingip_id>" format.
+type dnsFloatingIPPTRV2 struct {
+	ID          string `json:"id"`
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+// dnsFloatingIPPTRV2SetOpts represents the attributes used when setting the
+// PTR record of a floating IP.
+type dnsFloatingIPPTRV2SetOpts struct {
+	PTRDName    string  `json:"ptrdname" required:"true"`
+	Description *string `json:"description,omitempty"`
+	TTL         int     `json:"ttl,omitempty"`
+}
+
+func dnsFloatingIPPTRV2ID(region, floatingIPID string) string {
+	return fmt.Sprintf("%s:%s", region, floatingIPID)
+}
+
+func dnsFloatingIPPTRV2ParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_floatingip_ptr_v2 ID from raw ID: %s", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func dnsFloatingIPPTRV2Set(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsFloatingIPPTRV2SetOpts) (*dnsFloatingIPPTRV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsFloatingIPPTRV2
+
+	_, err = client.Patch(ctx, client.ServiceURL("reverse", "floatingips", id), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsFloatingIPPTRV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsFloatingIPPTRV2, error) {
+	var r dnsFloatingIPPTRV2
+
+	_, err := client.Get(ctx, client.ServiceURL("reverse", "floatingips", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// dnsFloatingIPPTRV2Unset removes the PTR record by setting its ptrdname to
+// null, which is the only way Designate offers to do so.
+func dnsFloatingIPPTRV2Unset(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	b := map[string]any{
+		"ptrdname": nil,
+	}
+
+	_, err := client.Patch(ctx, client.ServiceURL("reverse", "floatingips", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func dnsFloatingIPPTRV2RefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		ptr, err := dnsFloatingIPPTRV2Get(ctx, client, id)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		if ptr.PTRDName == "" && ptr.Status == "ACTIVE" {
+			return ptr, "DELETED", nil
+		}
+
+		log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 %s current status: %s", ptr.ID, ptr.Status)
+
+		return ptr, ptr.Status, nil
+	}
+}
@@ -0,0 +1,65 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Designate pools are not available in gophercloud yet, so the requests below
+// are issued against the DNS API directly.
+
+// dnsPoolV2 represents a Designate pool.
+type dnsPoolV2 struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	ProjectID   string              `json:"project_id"`
+	Attributes  map[string]string   `json:"attributes"`
+	NSRecords   []dnsPoolV2NSRecord `json:"ns_records"`
+	CreatedAt   string              `json:"created_at"`
+	UpdatedAt   string              `json:"updated_at"`
+}
+
+// dnsPoolV2NSRecord represents a NS record of a Designate pool.
+type dnsPoolV2NSRecord struct {
+	Hostname string `json:"hostname"`
+	Priority int    `json:"priority"`
+}
+
+func dnsPoolV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsPoolV2, error) {
+	var r dnsPoolV2
+
+	_, err := client.Get(ctx, client.ServiceURL("pools", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsPoolV2List(ctx context.Context, client *gophercloud.ServiceClient) ([]dnsPoolV2, error) {
+	var r struct {
+		Pools []dnsPoolV2 `json:"pools"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("pools"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Pools, nil
+}
+
+func flattenDNSPoolV2NSRecords(nsRecords []dnsPoolV2NSRecord) []map[string]any {
+	r := make([]map[string]any, len(nsRecords))
+
+	for i, nsRecord := range nsRecords {
+		r[i] = map[string]any{
+			"hostname": nsRecord.Hostname,
+			"priority": nsRecord.Priority,
+		}
+	}
+
+	return r
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/recordsets"
@@ -48,3 +49,26 @@ func dnsRecordSetV2RefreshFunc(ctx context.Context, dnsClient *gophercloud.Servi
 		return recordset, recordset.Status, nil
 	}
 }
+
+func flattenDNSRecordSetsV2(recordsetList []recordsets.RecordSet) []map[string]any {
+	m := make([]map[string]any, len(recordsetList))
+
+	for i, r := range recordsetList {
+		m[i] = map[string]any{
+			"id":          r.ID,
+			"name":        r.Name,
+			"zone_id":     r.ZoneID,
+			"zone_name":   r.ZoneName,
+			"project_id":  r.ProjectID,
+			"type":        r.Type,
+			"records":     r.Records,
+			"ttl":         r.TTL,
+			"description": r.Description,
+			"status":      r.Status,
+			"created_at":  r.CreatedAt.Format(time.RFC3339),
+			"updated_at":  r.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Designate TLDs are not available in gophercloud yet, so the requests below
+// are issued against the DNS API directly.
+
+// dnsTLDV2 represents a Designate top-level domain.
+type dnsTLDV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// dnsTLDV2Opts represents the attributes used when creating or updating a
+// TLD.
+type dnsTLDV2Opts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func dnsTLDV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts dnsTLDV2Opts) (*dnsTLDV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsTLDV2
+
+	_, err = client.Post(ctx, client.ServiceURL("tlds"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTLDV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*dnsTLDV2, error) {
+	var r dnsTLDV2
+
+	_, err := client.Get(ctx, client.ServiceURL("tlds", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTLDV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts dnsTLDV2Opts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(ctx, client.ServiceURL("tlds", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func dnsTLDV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("tlds", id), nil)
+
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/dns/v2/zones"
@@ -126,3 +127,28 @@ func getProjectFromToken(ctx context.Context, dnsClient *gophercloud.ServiceClie
 
 	return project, nil
 }
+
+func flattenDNSZonesV2(zoneList []zones.Zone) []map[string]any {
+	m := make([]map[string]any, len(zoneList))
+
+	for i, z := range zoneList {
+		m[i] = map[string]any{
+			"id":          z.ID,
+			"name":        z.Name,
+			"pool_id":     z.PoolID,
+			"project_id":  z.ProjectID,
+			"email":       z.Email,
+			"description": z.Description,
+			"type":        z.Type,
+			"status":      z.Status,
+			"ttl":         z.TTL,
+			"serial":      z.Serial,
+			"masters":     z.Masters,
+			"attributes":  z.Attributes,
+			"created_at":  z.CreatedAt.Format(time.RFC3339),
+			"updated_at":  z.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return m
+}
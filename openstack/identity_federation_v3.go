@@ -0,0 +1,219 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Identity providers and protocols are not available in gophercloud yet and
+// its mappings model the rules as typed structs, which drop any attribute
+// they don't know about. The OS-FEDERATION requests below are therefore
+// issued against the Identity API directly, passing the mapping rules
+// through as raw JSON.
+
+// identityFederationProviderV3 represents a federated identity provider.
+type identityFederationProviderV3 struct {
+	ID          string   `json:"id"`
+	DomainID    string   `json:"domain_id"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	RemoteIDs   []string `json:"remote_ids"`
+}
+
+// identityFederationProviderV3CreateOpts represents the attributes used when
+// registering a new identity provider.
+type identityFederationProviderV3CreateOpts struct {
+	DomainID    string   `json:"domain_id,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+	RemoteIDs   []string `json:"remote_ids,omitempty"`
+}
+
+// identityFederationProviderV3UpdateOpts represents the attributes used when
+// updating an existing identity provider.
+type identityFederationProviderV3UpdateOpts struct {
+	Description *string   `json:"description,omitempty"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+	RemoteIDs   *[]string `json:"remote_ids,omitempty"`
+}
+
+// identityFederationMappingV3 represents a federation mapping.
+type identityFederationMappingV3 struct {
+	ID    string          `json:"id"`
+	Rules json.RawMessage `json:"rules"`
+}
+
+// identityFederationProtocolV3 represents a federation protocol of an
+// identity provider.
+type identityFederationProtocolV3 struct {
+	ID                string `json:"id"`
+	MappingID         string `json:"mapping_id"`
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+}
+
+// identityFederationProtocolV3Opts represents the attributes used when
+// creating or updating a federation protocol.
+type identityFederationProtocolV3Opts struct {
+	MappingID         string `json:"mapping_id" required:"true"`
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+func identityFederationProviderV3Create(ctx context.Context, client *gophercloud.ServiceClient, id string, opts identityFederationProviderV3CreateOpts) (*identityFederationProviderV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "identity_provider")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		IdentityProvider identityFederationProviderV3 `json:"identity_provider"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", id), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.IdentityProvider, nil
+}
+
+func identityFederationProviderV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*identityFederationProviderV3, error) {
+	var r struct {
+		IdentityProvider identityFederationProviderV3 `json:"identity_provider"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.IdentityProvider, nil
+}
+
+func identityFederationProviderV3Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts identityFederationProviderV3UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "identity_provider")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func identityFederationProviderV3Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", id), nil)
+
+	return err
+}
+
+func identityFederationMappingV3Create(ctx context.Context, client *gophercloud.ServiceClient, id string, rules json.RawMessage) (*identityFederationMappingV3, error) {
+	b := map[string]any{
+		"mapping": map[string]any{
+			"rules": rules,
+		},
+	}
+
+	var r struct {
+		Mapping identityFederationMappingV3 `json:"mapping"`
+	}
+
+	_, err := client.Put(ctx, client.ServiceURL("OS-FEDERATION", "mappings", id), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Mapping, nil
+}
+
+func identityFederationMappingV3Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*identityFederationMappingV3, error) {
+	var r struct {
+		Mapping identityFederationMappingV3 `json:"mapping"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("OS-FEDERATION", "mappings", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Mapping, nil
+}
+
+func identityFederationMappingV3Update(ctx context.Context, client *gophercloud.ServiceClient, id string, rules json.RawMessage) error {
+	b := map[string]any{
+		"mapping": map[string]any{
+			"rules": rules,
+		},
+	}
+
+	_, err := client.Patch(ctx, client.ServiceURL("OS-FEDERATION", "mappings", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func identityFederationMappingV3Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("OS-FEDERATION", "mappings", id), nil)
+
+	return err
+}
+
+func identityFederationProtocolV3Create(ctx context.Context, client *gophercloud.ServiceClient, idpID, id string, opts identityFederationProtocolV3Opts) (*identityFederationProtocolV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "protocol")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Protocol identityFederationProtocolV3 `json:"protocol"`
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", id), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Protocol, nil
+}
+
+func identityFederationProtocolV3Get(ctx context.Context, client *gophercloud.ServiceClient, idpID, id string) (*identityFederationProtocolV3, error) {
+	var r struct {
+		Protocol identityFederationProtocolV3 `json:"protocol"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Protocol, nil
+}
+
+func identityFederationProtocolV3Update(ctx context.Context, client *gophercloud.ServiceClient, idpID, id string, opts identityFederationProtocolV3Opts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "protocol")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func identityFederationProtocolV3Delete(ctx context.Context, client *gophercloud.ServiceClient, idpID, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", id), nil)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageV3Backup_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_backup_v3.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			t.Skip("Currently Cinder Backup is not configured properly on GH-A devstack")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3BackupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force",
+					"incremental",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockStorageV3VolumeTransfer_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_volume_transfer_v3.transfer_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3VolumeTransferDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auth_key",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Octavia availability zones and availability zone profiles are not
+// available in gophercloud yet, so the requests below are issued against
+// the Load Balancing API directly.
+
+// lbAvailabilityZoneProfileV2 represents an Octavia availability zone profile.
+type lbAvailabilityZoneProfileV2 struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	ProviderName         string `json:"provider_name"`
+	AvailabilityZoneData string `json:"availability_zone_data"`
+}
+
+// lbAvailabilityZoneProfileV2CreateOpts represents the attributes used when
+// creating a new availability zone profile.
+type lbAvailabilityZoneProfileV2CreateOpts struct {
+	Name                 string `json:"name" required:"true"`
+	ProviderName         string `json:"provider_name" required:"true"`
+	AvailabilityZoneData string `json:"availability_zone_data" required:"true"`
+}
+
+// lbAvailabilityZoneProfileV2UpdateOpts represents the attributes used when
+// updating an existing availability zone profile.
+type lbAvailabilityZoneProfileV2UpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	ProviderName         *string `json:"provider_name,omitempty"`
+	AvailabilityZoneData *string `json:"availability_zone_data,omitempty"`
+}
+
+// lbAvailabilityZoneProfileV2ListOpts allows to filter the list of
+// availability zone profiles.
+type lbAvailabilityZoneProfileV2ListOpts struct {
+	Name         string `q:"name"`
+	ProviderName string `q:"provider_name"`
+}
+
+func lbAvailabilityZoneProfileV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2CreateOpts) (*lbAvailabilityZoneProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Profile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Profile, nil
+}
+
+func lbAvailabilityZoneProfileV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*lbAvailabilityZoneProfileV2, error) {
+	var r struct {
+		Profile lbAvailabilityZoneProfileV2 `json:"availability_zone_profile"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Profile, nil
+}
+
+func lbAvailabilityZoneProfileV2List(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneProfileV2ListOpts) ([]lbAvailabilityZoneProfileV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Profiles []lbAvailabilityZoneProfileV2 `json:"availability_zone_profiles"`
+	}
+
+	_, err = client.Get(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Profiles, nil
+}
+
+func lbAvailabilityZoneProfileV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts lbAvailabilityZoneProfileV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone_profile")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbAvailabilityZoneProfileV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzoneprofiles", id), nil)
+
+	return err
+}
+
+// lbAvailabilityZoneV2 represents an Octavia availability zone. Availability
+// zones are identified by their name.
+type lbAvailabilityZoneV2 struct {
+	Name                      string `json:"name"`
+	Description               string `json:"description"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id"`
+	Enabled                   bool   `json:"enabled"`
+}
+
+// lbAvailabilityZoneV2CreateOpts represents the attributes used when
+// creating a new availability zone.
+type lbAvailabilityZoneV2CreateOpts struct {
+	Name                      string `json:"name" required:"true"`
+	Description               string `json:"description,omitempty"`
+	AvailabilityZoneProfileID string `json:"availability_zone_profile_id" required:"true"`
+	Enabled                   *bool  `json:"enabled,omitempty"`
+}
+
+// lbAvailabilityZoneV2UpdateOpts represents the attributes used when
+// updating an existing availability zone.
+type lbAvailabilityZoneV2UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+func lbAvailabilityZoneV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts lbAvailabilityZoneV2CreateOpts) (*lbAvailabilityZoneV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("lbaas", "availabilityzones"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Get(ctx context.Context, client *gophercloud.ServiceClient, name string) (*lbAvailabilityZoneV2, error) {
+	var r struct {
+		AvailabilityZone lbAvailabilityZoneV2 `json:"availability_zone"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("lbaas", "availabilityzones", name), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.AvailabilityZone, nil
+}
+
+func lbAvailabilityZoneV2Update(ctx context.Context, client *gophercloud.ServiceClient, name string, opts lbAvailabilityZoneV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "availability_zone")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("lbaas", "availabilityzones", name), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbAvailabilityZoneV2Delete(ctx context.Context, client *gophercloud.ServiceClient, name string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("lbaas", "availabilityzones", name), nil)
+
+	return err
+}
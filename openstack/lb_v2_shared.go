@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/amphorae"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
@@ -150,6 +151,89 @@ func expandLBMembersV2(members *schema.Set) []pools.BatchUpdateMemberOpts {
 	return m
 }
 
+func flattenLBAmphoraeV2(amphoraeList []amphorae.Amphora) []map[string]any {
+	m := make([]map[string]any, len(amphoraeList))
+
+	for i, a := range amphoraeList {
+		m[i] = map[string]any{
+			"id":              a.ID,
+			"loadbalancer_id": a.LoadbalancerID,
+			"compute_id":      a.ComputeID,
+			"lb_network_ip":   a.LBNetworkIP,
+			"ha_ip":           a.HAIP,
+			"ha_port_id":      a.HAPortID,
+			"vrrp_ip":         a.VRRPIP,
+			"vrrp_port_id":    a.VRRPPortID,
+			"role":            a.Role,
+			"status":          a.Status,
+			"image_id":        a.ImageID,
+			"cached_zone":     a.CachedZone,
+			"cert_expiration": a.CertExpiration.Format(time.RFC3339),
+			"created_at":      a.CreatedAt.Format(time.RFC3339),
+			"updated_at":      a.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return m
+}
+
+func flattenLBStatusTreeListenersV2(listeners []listeners.Listener) []map[string]any {
+	m := make([]map[string]any, len(listeners))
+
+	for i, listener := range listeners {
+		m[i] = map[string]any{
+			"id":                  listener.ID,
+			"name":                listener.Name,
+			"provisioning_status": listener.ProvisioningStatus,
+			"operating_status":    listener.OperatingStatus,
+			"pools":               flattenLBStatusTreePoolsV2(listener.Pools),
+		}
+	}
+
+	return m
+}
+
+func flattenLBStatusTreePoolsV2(pools []pools.Pool) []map[string]any {
+	m := make([]map[string]any, len(pools))
+
+	for i, pool := range pools {
+		var healthmonitor []map[string]any
+		if pool.Monitor.ID != "" {
+			healthmonitor = []map[string]any{
+				{
+					"id":                  pool.Monitor.ID,
+					"type":                pool.Monitor.Type,
+					"provisioning_status": pool.Monitor.ProvisioningStatus,
+					"operating_status":    pool.Monitor.OperatingStatus,
+				},
+			}
+		}
+
+		members := make([]map[string]any, len(pool.Members))
+		for j, member := range pool.Members {
+			members[j] = map[string]any{
+				"id":                  member.ID,
+				"name":                member.Name,
+				"address":             member.Address,
+				"protocol_port":       member.ProtocolPort,
+				"provisioning_status": member.ProvisioningStatus,
+				"operating_status":    member.OperatingStatus,
+			}
+		}
+
+		m[i] = map[string]any{
+			"id":                  pool.ID,
+			"name":                pool.Name,
+			"provisioning_status": pool.ProvisioningStatus,
+			"operating_status":    pool.OperatingStatus,
+			"healthmonitor":       healthmonitor,
+			"members":             members,
+		}
+	}
+
+	return m
+}
+
 func flattenLBPoolsV2(pools []pools.Pool) []map[string]any {
 	p := make([]map[string]any, len(pools))
 
@@ -223,6 +307,18 @@ func flattenLBPoliciesV2(policies []l7policies.L7Policy) []map[string]any {
 	return p
 }
 
+func flattenLBL7RulesV2(rules []l7policies.Rule) []map[string]any {
+	r := make([]map[string]any, len(rules))
+
+	for i, rule := range rules {
+		r[i] = map[string]any{
+			"id": rule.ID,
+		}
+	}
+
+	return r
+}
+
 func flattenLBListenersV2(listeners []listeners.Listener) []map[string]any {
 	l := make([]map[string]any, len(listeners))
 
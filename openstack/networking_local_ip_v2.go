@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Neutron local IPs and local IP port associations are not available in
+// gophercloud yet, so the requests below are issued against the Networking
+// API directly.
+
+// networkingLocalIPV2 represents a Neutron local IP.
+type networkingLocalIPV2 struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	ProjectID      string   `json:"project_id"`
+	LocalPortID    string   `json:"local_port_id"`
+	NetworkID      string   `json:"network_id"`
+	LocalIPAddress string   `json:"local_ip_address"`
+	IPMode         string   `json:"ip_mode"`
+	Tags           []string `json:"tags"`
+}
+
+// networkingLocalIPV2CreateOpts represents the attributes used when creating
+// a new local IP.
+type networkingLocalIPV2CreateOpts struct {
+	Name           string            `json:"name,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	ProjectID      string            `json:"project_id,omitempty"`
+	LocalPortID    string            `json:"local_port_id,omitempty"`
+	NetworkID      string            `json:"network_id,omitempty"`
+	LocalIPAddress string            `json:"local_ip_address,omitempty"`
+	IPMode         string            `json:"ip_mode,omitempty"`
+	ValueSpecs     map[string]string `json:"value_specs,omitempty"`
+}
+
+// networkingLocalIPV2UpdateOpts represents the attributes used when updating
+// an existing local IP.
+type networkingLocalIPV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func networkingLocalIPV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingLocalIPV2CreateOpts) (*networkingLocalIPV2, error) {
+	b, err := BuildRequest(opts, "local_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		LocalIP networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("local_ips"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.LocalIP, nil
+}
+
+func networkingLocalIPV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingLocalIPV2, error) {
+	var r struct {
+		LocalIP networkingLocalIPV2 `json:"local_ip"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("local_ips", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.LocalIP, nil
+}
+
+func networkingLocalIPV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts networkingLocalIPV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("local_ips", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func networkingLocalIPV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("local_ips", id), nil)
+
+	return err
+}
+
+// networkingLocalIPAssociationV2 represents the association of a local IP
+// with a fixed port.
+type networkingLocalIPAssociationV2 struct {
+	LocalIPID      string `json:"local_ip_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	FixedPortID    string `json:"fixed_port_id"`
+	FixedIP        string `json:"fixed_ip"`
+	Host           string `json:"host"`
+}
+
+// networkingLocalIPAssociationV2CreateOpts represents the attributes used
+// when associating a local IP with a fixed port.
+type networkingLocalIPAssociationV2CreateOpts struct {
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+	FixedIP     string `json:"fixed_ip,omitempty"`
+}
+
+// networkingLocalIPAssociationV2ListOpts allows to filter the list of local
+// IP associations.
+type networkingLocalIPAssociationV2ListOpts struct {
+	FixedPortID string `q:"fixed_port_id"`
+}
+
+func networkingLocalIPAssociationV2Create(ctx context.Context, client *gophercloud.ServiceClient, localIPID string, opts networkingLocalIPAssociationV2CreateOpts) (*networkingLocalIPAssociationV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "port_association")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Association networkingLocalIPAssociationV2 `json:"port_association"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("local_ips", localIPID, "port_associations"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Association, nil
+}
+
+func networkingLocalIPAssociationV2List(ctx context.Context, client *gophercloud.ServiceClient, localIPID string, opts networkingLocalIPAssociationV2ListOpts) ([]networkingLocalIPAssociationV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Associations []networkingLocalIPAssociationV2 `json:"port_associations"`
+	}
+
+	_, err = client.Get(ctx, client.ServiceURL("local_ips", localIPID, "port_associations")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Associations, nil
+}
+
+func networkingLocalIPAssociationV2Delete(ctx context.Context, client *gophercloud.ServiceClient, localIPID, fixedPortID string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("local_ips", localIPID, "port_associations", fixedPortID), nil)
+
+	return err
+}
@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Neutron metering labels and metering label rules are not available in
+// gophercloud yet, so the requests below are issued against the Networking
+// API directly. Neither of them can be updated once created.
+
+// networkingMeteringLabelV2 represents a Neutron metering label.
+type networkingMeteringLabelV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectID   string `json:"project_id"`
+	Shared      bool   `json:"shared"`
+}
+
+// networkingMeteringLabelV2CreateOpts represents the attributes used when
+// creating a new metering label.
+type networkingMeteringLabelV2CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	Shared      *bool  `json:"shared,omitempty"`
+}
+
+func networkingMeteringLabelV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingMeteringLabelV2CreateOpts) (*networkingMeteringLabelV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "metering_label")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Label networkingMeteringLabelV2 `json:"metering_label"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("metering", "metering-labels"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Label, nil
+}
+
+func networkingMeteringLabelV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingMeteringLabelV2, error) {
+	var r struct {
+		Label networkingMeteringLabelV2 `json:"metering_label"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("metering", "metering-labels", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Label, nil
+}
+
+func networkingMeteringLabelV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("metering", "metering-labels", id), nil)
+
+	return err
+}
+
+// networkingMeteringLabelRuleV2 represents a Neutron metering label rule.
+type networkingMeteringLabelRuleV2 struct {
+	ID                  string `json:"id"`
+	MeteringLabelID     string `json:"metering_label_id"`
+	Direction           string `json:"direction"`
+	RemoteIPPrefix      string `json:"remote_ip_prefix"`
+	SourceIPPrefix      string `json:"source_ip_prefix"`
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+	Excluded            bool   `json:"excluded"`
+}
+
+// networkingMeteringLabelRuleV2CreateOpts represents the attributes used when
+// creating a new metering label rule.
+type networkingMeteringLabelRuleV2CreateOpts struct {
+	MeteringLabelID     string `json:"metering_label_id" required:"true"`
+	Direction           string `json:"direction,omitempty"`
+	RemoteIPPrefix      string `json:"remote_ip_prefix,omitempty"`
+	SourceIPPrefix      string `json:"source_ip_prefix,omitempty"`
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+	Excluded            *bool  `json:"excluded,omitempty"`
+}
+
+func networkingMeteringLabelRuleV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts networkingMeteringLabelRuleV2CreateOpts) (*networkingMeteringLabelRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "metering_label_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Rule networkingMeteringLabelRuleV2 `json:"metering_label_rule"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("metering", "metering-label-rules"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingMeteringLabelRuleV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*networkingMeteringLabelRuleV2, error) {
+	var r struct {
+		Rule networkingMeteringLabelRuleV2 `json:"metering_label_rule"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("metering", "metering-label-rules", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingMeteringLabelRuleV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("metering", "metering-label-rules", id), nil)
+
+	return err
+}
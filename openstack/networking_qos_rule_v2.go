@@ -70,3 +70,246 @@ func networkingQoSMinimumBandwidthRuleV2StateRefreshFunc(ctx context.Context, cl
 		return policy, "ACTIVE", nil
 	}
 }
+
+// Packet rate limit and minimum packet rate rules are not available in
+// gophercloud yet, so the requests below are issued against the Networking
+// API directly.
+
+// networkingQoSPacketRateLimitRuleV2 represents a QoS packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2 struct {
+	ID           string `json:"id"`
+	MaxKpps      int    `json:"max_kpps"`
+	MaxBurstKpps int    `json:"max_burst_kpps"`
+	Direction    string `json:"direction"`
+}
+
+// networkingQoSPacketRateLimitRuleV2CreateOpts represents the attributes used
+// when creating a new packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2CreateOpts struct {
+	MaxKpps      int    `json:"max_kpps"`
+	MaxBurstKpps int    `json:"max_burst_kpps,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// networkingQoSPacketRateLimitRuleV2UpdateOpts represents the attributes used
+// when updating an existing packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2UpdateOpts struct {
+	MaxKpps      *int   `json:"max_kpps,omitempty"`
+	MaxBurstKpps *int   `json:"max_burst_kpps,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// networkingQoSPacketRateLimitRuleV2ListOpts allows to filter the list of
+// packet rate limit rules.
+type networkingQoSPacketRateLimitRuleV2ListOpts struct {
+	MaxKpps      int    `q:"max_kpps"`
+	MaxBurstKpps int    `q:"max_burst_kpps"`
+	Direction    string `q:"direction"`
+}
+
+func networkingQoSPacketRateLimitRuleV2Create(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts networkingQoSPacketRateLimitRuleV2CreateOpts) (*networkingQoSPacketRateLimitRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Rule networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rule"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingQoSPacketRateLimitRuleV2Get(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) (*networkingQoSPacketRateLimitRuleV2, error) {
+	var r struct {
+		Rule networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rule"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingQoSPacketRateLimitRuleV2List(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts networkingQoSPacketRateLimitRuleV2ListOpts) ([]networkingQoSPacketRateLimitRuleV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Rules []networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rules"`
+	}
+
+	_, err = client.Get(ctx, client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Rules, nil
+}
+
+func networkingQoSPacketRateLimitRuleV2Update(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string, opts networkingQoSPacketRateLimitRuleV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func networkingQoSPacketRateLimitRuleV2Delete(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), nil)
+
+	return err
+}
+
+func networkingQoSPacketRateLimitRuleV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		policy, err := networkingQoSPacketRateLimitRuleV2Get(ctx, client, policyID, ruleID)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return policy, "DELETED", nil
+			}
+
+			if gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+				return policy, "ACTIVE", nil
+			}
+
+			return nil, "", err
+		}
+
+		return policy, "ACTIVE", nil
+	}
+}
+
+// networkingQoSMinimumPacketRateRuleV2 represents a QoS minimum packet rate
+// rule.
+type networkingQoSMinimumPacketRateRuleV2 struct {
+	ID        string `json:"id"`
+	MinKpps   int    `json:"min_kpps"`
+	Direction string `json:"direction"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2CreateOpts represents the attributes
+// used when creating a new minimum packet rate rule.
+type networkingQoSMinimumPacketRateRuleV2CreateOpts struct {
+	MinKpps   int    `json:"min_kpps"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2UpdateOpts represents the attributes
+// used when updating an existing minimum packet rate rule.
+type networkingQoSMinimumPacketRateRuleV2UpdateOpts struct {
+	MinKpps   *int   `json:"min_kpps,omitempty"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2ListOpts allows to filter the list of
+// minimum packet rate rules.
+type networkingQoSMinimumPacketRateRuleV2ListOpts struct {
+	MinKpps   int    `q:"min_kpps"`
+	Direction string `q:"direction"`
+}
+
+func networkingQoSMinimumPacketRateRuleV2Create(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts networkingQoSMinimumPacketRateRuleV2CreateOpts) (*networkingQoSMinimumPacketRateRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Rule networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rule"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingQoSMinimumPacketRateRuleV2Get(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) (*networkingQoSMinimumPacketRateRuleV2, error) {
+	var r struct {
+		Rule networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rule"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Rule, nil
+}
+
+func networkingQoSMinimumPacketRateRuleV2List(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts networkingQoSMinimumPacketRateRuleV2ListOpts) ([]networkingQoSMinimumPacketRateRuleV2, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Rules []networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rules"`
+	}
+
+	_, err = client.Get(ctx, client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules")+q.String(), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Rules, nil
+}
+
+func networkingQoSMinimumPacketRateRuleV2Update(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string, opts networkingQoSMinimumPacketRateRuleV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func networkingQoSMinimumPacketRateRuleV2Delete(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), nil)
+
+	return err
+}
+
+func networkingQoSMinimumPacketRateRuleV2StateRefreshFunc(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		policy, err := networkingQoSMinimumPacketRateRuleV2Get(ctx, client, policyID, ruleID)
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return policy, "DELETED", nil
+			}
+
+			if gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+				return policy, "ACTIVE", nil
+			}
+
+			return nil, "", err
+		}
+
+		return policy, "ACTIVE", nil
+	}
+}
@@ -331,182 +331,232 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_availability_zones_v3":       dataSourceBlockStorageAvailabilityZonesV3(),
-			"openstack_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
-			"openstack_blockstorage_volume_v3":                   dataSourceBlockStorageVolumeV3(),
-			"openstack_blockstorage_quotaset_v3":                 dataSourceBlockStorageQuotasetV3(),
-			"openstack_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
-			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
-			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
-			"openstack_compute_flavor_v2":                        dataSourceComputeFlavorV2(),
-			"openstack_compute_hypervisor_v2":                    dataSourceComputeHypervisorV2(),
-			"openstack_compute_servergroup_v2":                   dataSourceComputeServerGroupV2(),
-			"openstack_compute_keypair_v2":                       dataSourceComputeKeypairV2(),
-			"openstack_compute_quotaset_v2":                      dataSourceComputeQuotasetV2(),
-			"openstack_compute_limits_v2":                        dataSourceComputeLimitsV2(),
-			"openstack_containerinfra_nodegroup_v1":              dataSourceContainerInfraNodeGroupV1(),
-			"openstack_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
-			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
-			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
-			"openstack_dns_zone_share_v2":                        dataSourceDNSZoneShareV2(),
-			"openstack_fw_group_v2":                              dataSourceFWGroupV2(),
-			"openstack_fw_policy_v2":                             dataSourceFWPolicyV2(),
-			"openstack_fw_rule_v2":                               dataSourceFWRuleV2(),
-			"openstack_identity_role_v3":                         dataSourceIdentityRoleV3(),
-			"openstack_identity_project_v3":                      dataSourceIdentityProjectV3(),
-			"openstack_identity_project_ids_v3":                  dataSourceIdentityProjectIDsV3(),
-			"openstack_identity_user_v3":                         dataSourceIdentityUserV3(),
-			"openstack_identity_auth_scope_v3":                   dataSourceIdentityAuthScopeV3(),
-			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
-			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
-			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
-			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
-			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
-			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
-			"openstack_networking_network_v2":                    dataSourceNetworkingNetworkV2(),
-			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
-			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
-			"openstack_networking_qos_minimum_bandwidth_rule_v2": dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
-			"openstack_networking_qos_policy_v2":                 dataSourceNetworkingQoSPolicyV2(),
-			"openstack_networking_quota_v2":                      dataSourceNetworkingQuotaV2(),
-			"openstack_networking_subnet_v2":                     dataSourceNetworkingSubnetV2(),
-			"openstack_networking_subnet_ids_v2":                 dataSourceNetworkingSubnetIDsV2(),
-			"openstack_networking_secgroup_v2":                   dataSourceNetworkingSecGroupV2(),
-			"openstack_networking_subnetpool_v2":                 dataSourceNetworkingSubnetPoolV2(),
-			"openstack_networking_floatingip_v2":                 dataSourceNetworkingFloatingIPV2(),
-			"openstack_networking_router_v2":                     dataSourceNetworkingRouterV2(),
-			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
-			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
-			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
-			"openstack_networking_segment_v2":                    dataSourceNetworkingSegmentV2(),
-			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
-			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
-			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
-			"openstack_sharedfilesystem_snapshot_v2":             dataSourceSharedFilesystemSnapshotV2(),
-			"openstack_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
-			"openstack_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
-			"openstack_loadbalancer_flavor_v2":                   dataSourceLoadBalancerFlavorV2(),
-			"openstack_lb_flavor_v2":                             dataSourceLBFlavorV2(),
-			"openstack_lb_flavorprofile_v2":                      dataSourceLBFlavorProfileV2(),
-			"openstack_lb_loadbalancer_v2":                       dataSourceLBLoadbalancerV2(),
-			"openstack_lb_listener_v2":                           dataSourceLBListenerV2(),
-			"openstack_lb_member_v2":                             dataSourceLBMemberV2(),
-			"openstack_lb_monitor_v2":                            dataSourceLBMonitorV2(),
-			"openstack_lb_pool_v2":                               dataSourceLBPoolV2(),
-			"openstack_workflow_cron_trigger_v2":                 dataSourceWorkflowCronTriggerV2(),
-			"openstack_workflow_workflow_v2":                     dataSourceWorkflowWorkflowV2(),
+			"openstack_blockstorage_availability_zones_v3":         dataSourceBlockStorageAvailabilityZonesV3(),
+			"openstack_blockstorage_backup_v3":                     dataSourceBlockStorageBackupV3(),
+			"openstack_blockstorage_snapshot_v3":                   dataSourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_v3":                     dataSourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_quotaset_v3":                   dataSourceBlockStorageQuotasetV3(),
+			"openstack_compute_aggregate_v2":                       dataSourceComputeAggregateV2(),
+			"openstack_compute_availability_zones_v2":              dataSourceComputeAvailabilityZonesV2(),
+			"openstack_compute_instance_v2":                        dataSourceComputeInstanceV2(),
+			"openstack_compute_flavor_v2":                          dataSourceComputeFlavorV2(),
+			"openstack_compute_hypervisor_v2":                      dataSourceComputeHypervisorV2(),
+			"openstack_compute_servergroup_v2":                     dataSourceComputeServerGroupV2(),
+			"openstack_compute_keypair_v2":                         dataSourceComputeKeypairV2(),
+			"openstack_compute_quotaset_v2":                        dataSourceComputeQuotasetV2(),
+			"openstack_compute_limits_v2":                          dataSourceComputeLimitsV2(),
+			"openstack_containerinfra_nodegroup_v1":                dataSourceContainerInfraNodeGroupV1(),
+			"openstack_containerinfra_clustertemplate_v1":          dataSourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_cluster_v1":                  dataSourceContainerInfraCluster(),
+			"openstack_dns_zone_v2":                                dataSourceDNSZoneV2(),
+			"openstack_dns_zone_share_v2":                          dataSourceDNSZoneShareV2(),
+			"openstack_dns_pool_v2":                                dataSourceDNSPoolV2(),
+			"openstack_dns_zones_v2":                               dataSourceDNSZonesV2(),
+			"openstack_dns_recordset_v2":                           dataSourceDNSRecordSetV2(),
+			"openstack_dns_recordsets_v2":                          dataSourceDNSRecordSetsV2(),
+			"openstack_fw_group_v2":                                dataSourceFWGroupV2(),
+			"openstack_fw_policy_v2":                               dataSourceFWPolicyV2(),
+			"openstack_fw_rule_v2":                                 dataSourceFWRuleV2(),
+			"openstack_identity_role_v3":                           dataSourceIdentityRoleV3(),
+			"openstack_identity_project_v3":                        dataSourceIdentityProjectV3(),
+			"openstack_identity_project_ids_v3":                    dataSourceIdentityProjectIDsV3(),
+			"openstack_identity_user_v3":                           dataSourceIdentityUserV3(),
+			"openstack_identity_auth_scope_v3":                     dataSourceIdentityAuthScopeV3(),
+			"openstack_identity_endpoint_v3":                       dataSourceIdentityEndpointV3(),
+			"openstack_identity_service_v3":                        dataSourceIdentityServiceV3(),
+			"openstack_identity_domain_v3":                         dataSourceIdentityDomainV3(),
+			"openstack_identity_group_v3":                          dataSourceIdentityGroupV3(),
+			"openstack_images_image_v2":                            dataSourceImagesImageV2(),
+			"openstack_images_image_ids_v2":                        dataSourceImagesImageIDsV2(),
+			"openstack_networking_addressscope_v2":                 dataSourceNetworkingAddressScopeV2(),
+			"openstack_networking_network_v2":                      dataSourceNetworkingNetworkV2(),
+			"openstack_networking_loggable_resources_v2":           dataSourceNetworkingLoggableResourcesV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":     dataSourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":        dataSourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2":   dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_qos_minimum_packet_rate_rule_v2": dataSourceNetworkingQoSMinimumPacketRateRuleV2(),
+			"openstack_networking_qos_packet_rate_limit_rule_v2":   dataSourceNetworkingQoSPacketRateLimitRuleV2(),
+			"openstack_networking_qos_policy_v2":                   dataSourceNetworkingQoSPolicyV2(),
+			"openstack_networking_quota_v2":                        dataSourceNetworkingQuotaV2(),
+			"openstack_networking_subnet_v2":                       dataSourceNetworkingSubnetV2(),
+			"openstack_networking_subnet_ids_v2":                   dataSourceNetworkingSubnetIDsV2(),
+			"openstack_networking_secgroup_v2":                     dataSourceNetworkingSecGroupV2(),
+			"openstack_networking_subnetpool_v2":                   dataSourceNetworkingSubnetPoolV2(),
+			"openstack_networking_floatingip_v2":                   dataSourceNetworkingFloatingIPV2(),
+			"openstack_networking_router_v2":                       dataSourceNetworkingRouterV2(),
+			"openstack_networking_port_v2":                         dataSourceNetworkingPortV2(),
+			"openstack_networking_port_ids_v2":                     dataSourceNetworkingPortIDsV2(),
+			"openstack_networking_trunk_v2":                        dataSourceNetworkingTrunkV2(),
+			"openstack_networking_segment_v2":                      dataSourceNetworkingSegmentV2(),
+			"openstack_sharedfilesystem_availability_zones_v2":     dataSourceSharedFilesystemAvailabilityZonesV2(),
+			"openstack_sharedfilesystem_sharenetwork_v2":           dataSourceSharedFilesystemShareNetworkV2(),
+			"openstack_sharedfilesystem_share_v2":                  dataSourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_snapshot_v2":               dataSourceSharedFilesystemSnapshotV2(),
+			"openstack_keymanager_secret_v1":                       dataSourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                    dataSourceKeyManagerContainerV1(),
+			"openstack_loadbalancer_flavor_v2":                     dataSourceLoadBalancerFlavorV2(),
+			"openstack_lb_flavor_v2":                               dataSourceLBFlavorV2(),
+			"openstack_lb_flavorprofile_v2":                        dataSourceLBFlavorProfileV2(),
+			"openstack_lb_availability_zone_v2":                    dataSourceLBAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":            dataSourceLBAvailabilityZoneProfileV2(),
+			"openstack_lb_amphorae_v2":                             dataSourceLBAmphoraeV2(),
+			"openstack_lb_loadbalancer_v2":                         dataSourceLBLoadbalancerV2(),
+			"openstack_lb_loadbalancer_status_v2":                  dataSourceLBLoadbalancerStatusV2(),
+			"openstack_lb_l7policy_v2":                             dataSourceLBL7PolicyV2(),
+			"openstack_lb_l7rule_v2":                               dataSourceLBL7RuleV2(),
+			"openstack_lb_stats_v2":                                dataSourceLBStatsV2(),
+			"openstack_lb_listener_v2":                             dataSourceLBListenerV2(),
+			"openstack_lb_member_v2":                               dataSourceLBMemberV2(),
+			"openstack_lb_monitor_v2":                              dataSourceLBMonitorV2(),
+			"openstack_lb_pool_v2":                                 dataSourceLBPoolV2(),
+			"openstack_workflow_cron_trigger_v2":                   dataSourceWorkflowCronTriggerV2(),
+			"openstack_workflow_workflow_v2":                       dataSourceWorkflowWorkflowV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_qos_association_v3":          resourceBlockStorageQosAssociationV3(),
-			"openstack_blockstorage_qos_v3":                      resourceBlockStorageQosV3(),
-			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
-			"openstack_blockstorage_volume_v3":                   resourceBlockStorageVolumeV3(),
-			"openstack_blockstorage_volume_attach_v3":            resourceBlockStorageVolumeAttachV3(),
-			"openstack_blockstorage_volume_type_access_v3":       resourceBlockstorageVolumeTypeAccessV3(),
-			"openstack_blockstorage_volume_type_v3":              resourceBlockStorageVolumeTypeV3(),
-			"openstack_compute_aggregate_v2":                     resourceComputeAggregateV2(),
-			"openstack_compute_flavor_v2":                        resourceComputeFlavorV2(),
-			"openstack_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
-			"openstack_compute_instance_v2":                      resourceComputeInstanceV2(),
-			"openstack_compute_interface_attach_v2":              resourceComputeInterfaceAttachV2(),
-			"openstack_compute_keypair_v2":                       resourceComputeKeypairV2(),
-			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
-			"openstack_compute_quotaset_v2":                      resourceComputeQuotasetV2(),
-			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
-			"openstack_containerinfra_nodegroup_v1":              resourceContainerInfraNodeGroupV1(),
-			"openstack_containerinfra_clustertemplate_v1":        resourceContainerInfraClusterTemplateV1(),
-			"openstack_containerinfra_cluster_v1":                resourceContainerInfraClusterV1(),
-			"openstack_db_instance_v1":                           resourceDatabaseInstanceV1(),
-			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
-			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
-			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
-			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
-			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
-			"openstack_dns_zone_share_v2":                        resourceDNSZoneShareV2(),
-			"openstack_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
-			"openstack_dns_transfer_accept_v2":                   resourceDNSTransferAcceptV2(),
-			"openstack_dns_quota_v2":                             resourceDNSQuotaV2(),
-			"openstack_fw_group_v2":                              resourceFWGroupV2(),
-			"openstack_fw_policy_v2":                             resourceFWPolicyV2(),
-			"openstack_fw_rule_v2":                               resourceFWRuleV2(),
-			"openstack_identity_endpoint_v3":                     resourceIdentityEndpointV3(),
-			"openstack_identity_project_v3":                      resourceIdentityProjectV3(),
-			"openstack_identity_role_v3":                         resourceIdentityRoleV3(),
-			"openstack_identity_role_assignment_v3":              resourceIdentityRoleAssignmentV3(),
-			"openstack_identity_inherit_role_assignment_v3":      resourceIdentityInheritRoleAssignmentV3(),
-			"openstack_identity_service_v3":                      resourceIdentityServiceV3(),
-			"openstack_identity_user_v3":                         resourceIdentityUserV3(),
-			"openstack_identity_user_membership_v3":              resourceIdentityUserMembershipV3(),
-			"openstack_identity_group_v3":                        resourceIdentityGroupV3(),
-			"openstack_identity_application_credential_v3":       resourceIdentityApplicationCredentialV3(),
-			"openstack_identity_ec2_credential_v3":               resourceIdentityEc2CredentialV3(),
-			"openstack_identity_registered_limit_v3":             resourceIdentityRegisteredLimitV3(),
-			"openstack_identity_limit_v3":                        resourceIdentityLimitV3(),
-			"openstack_images_image_v2":                          resourceImagesImageV2(),
-			"openstack_images_image_access_v2":                   resourceImagesImageAccessV2(),
-			"openstack_images_image_access_accept_v2":            resourceImagesImageAccessAcceptV2(),
-			"openstack_lb_flavor_v2":                             resourceLoadBalancerFlavorV2(),
-			"openstack_lb_flavorprofile_v2":                      resourceLoadBalancerFlavorProfileV2(),
-			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
-			"openstack_lb_listener_v2":                           resourceListenerV2(),
-			"openstack_lb_pool_v2":                               resourcePoolV2(),
-			"openstack_lb_member_v2":                             resourceMemberV2(),
-			"openstack_lb_members_v2":                            resourceMembersV2(),
-			"openstack_lb_monitor_v2":                            resourceMonitorV2(),
-			"openstack_lb_l7policy_v2":                           resourceL7PolicyV2(),
-			"openstack_lb_l7rule_v2":                             resourceL7RuleV2(),
-			"openstack_lb_quota_v2":                              resourceLoadBalancerQuotaV2(),
-			"openstack_networking_bgp_speaker_v2":                resourceNetworkingBGPSpeakerV2(),
-			"openstack_networking_bgp_peer_v2":                   resourceNetworkingBGPPeerV2(),
-			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
-			"openstack_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
-			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
-			"openstack_networking_port_v2":                       resourceNetworkingPortV2(),
-			"openstack_networking_rbac_policy_v2":                resourceNetworkingRBACPolicyV2(),
-			"openstack_networking_port_secgroup_associate_v2":    resourceNetworkingPortSecGroupAssociateV2(),
-			"openstack_networking_qos_bandwidth_limit_rule_v2":   resourceNetworkingQoSBandwidthLimitRuleV2(),
-			"openstack_networking_qos_dscp_marking_rule_v2":      resourceNetworkingQoSDSCPMarkingRuleV2(),
-			"openstack_networking_qos_minimum_bandwidth_rule_v2": resourceNetworkingQoSMinimumBandwidthRuleV2(),
-			"openstack_networking_qos_policy_v2":                 resourceNetworkingQoSPolicyV2(),
-			"openstack_networking_quota_v2":                      resourceNetworkingQuotaV2(),
-			"openstack_networking_router_v2":                     resourceNetworkingRouterV2(),
-			"openstack_networking_router_interface_v2":           resourceNetworkingRouterInterfaceV2(),
-			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
-			"openstack_networking_router_routes_v2":              resourceNetworkingRouterRoutesV2(),
-			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
-			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
-			"openstack_networking_address_group_v2":              resourceNetworkingAddressGroupV2(),
-			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
-			"openstack_networking_subnet_route_v2":               resourceNetworkingSubnetRouteV2(),
-			"openstack_networking_subnetpool_v2":                 resourceNetworkingSubnetPoolV2(),
-			"openstack_networking_addressscope_v2":               resourceNetworkingAddressScopeV2(),
-			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
-			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
-			"openstack_networking_segment_v2":                    resourceNetworkingSegmentV2(),
-			"openstack_objectstorage_account_v1":                 resourceObjectStorageAccountV1(),
-			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
-			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
-			"openstack_objectstorage_tempurl_v1":                 resourceObjectstorageTempurlV1(),
-			"openstack_orchestration_stack_v1":                   resourceOrchestrationStackV1(),
-			"openstack_taas_tap_mirror_v2":                       resourceTapMirrorV2(),
-			"openstack_vpnaas_ipsec_policy_v2":                   resourceIPSecPolicyV2(),
-			"openstack_vpnaas_service_v2":                        resourceServiceV2(),
-			"openstack_vpnaas_ike_policy_v2":                     resourceIKEPolicyV2(),
-			"openstack_vpnaas_endpoint_group_v2":                 resourceEndpointGroupV2(),
-			"openstack_vpnaas_site_connection_v2":                resourceSiteConnectionV2(),
-			"openstack_sharedfilesystem_securityservice_v2":      resourceSharedFilesystemSecurityServiceV2(),
-			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
-			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
-			"openstack_sharedfilesystem_share_access_v2":         resourceSharedFilesystemShareAccessV2(),
-			"openstack_keymanager_secret_v1":                     resourceKeyManagerSecretV1(),
-			"openstack_keymanager_container_v1":                  resourceKeyManagerContainerV1(),
-			"openstack_keymanager_order_v1":                      resourceKeyManagerOrderV1(),
-			"openstack_bgpvpn_v2":                                resourceBGPVPNV2(),
-			"openstack_bgpvpn_network_associate_v2":              resourceBGPVPNNetworkAssociateV2(),
-			"openstack_bgpvpn_router_associate_v2":               resourceBGPVPNRouterAssociateV2(),
-			"openstack_bgpvpn_port_associate_v2":                 resourceBGPVPNPortAssociateV2(),
-			"openstack_workflow_cron_trigger_v2":                 resourceWorkflowCronTriggerV2(),
+			"openstack_blockstorage_backup_v3":                     resourceBlockStorageBackupV3(),
+			"openstack_blockstorage_backup_restore_v3":             resourceBlockStorageBackupRestoreV3(),
+			"openstack_blockstorage_qos_association_v3":            resourceBlockStorageQosAssociationV3(),
+			"openstack_blockstorage_qos_v3":                        resourceBlockStorageQosV3(),
+			"openstack_blockstorage_quotaset_v3":                   resourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_snapshot_v3":                   resourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_v3":                     resourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_volume_attach_v3":              resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_volume_type_access_v3":         resourceBlockstorageVolumeTypeAccessV3(),
+			"openstack_blockstorage_volume_type_v3":                resourceBlockStorageVolumeTypeV3(),
+			"openstack_blockstorage_volume_transfer_v3":            resourceBlockStorageVolumeTransferV3(),
+			"openstack_blockstorage_volume_transfer_accept_v3":     resourceBlockStorageVolumeTransferAcceptV3(),
+			"openstack_blockstorage_group_type_v3":                 resourceBlockStorageGroupTypeV3(),
+			"openstack_blockstorage_group_v3":                      resourceBlockStorageGroupV3(),
+			"openstack_blockstorage_group_snapshot_v3":             resourceBlockStorageGroupSnapshotV3(),
+			"openstack_compute_aggregate_v2":                       resourceComputeAggregateV2(),
+			"openstack_compute_flavor_v2":                          resourceComputeFlavorV2(),
+			"openstack_compute_flavor_access_v2":                   resourceComputeFlavorAccessV2(),
+			"openstack_compute_instance_v2":                        resourceComputeInstanceV2(),
+			"openstack_compute_interface_attach_v2":                resourceComputeInterfaceAttachV2(),
+			"openstack_compute_keypair_v2":                         resourceComputeKeypairV2(),
+			"openstack_compute_servergroup_v2":                     resourceComputeServerGroupV2(),
+			"openstack_compute_quotaset_v2":                        resourceComputeQuotasetV2(),
+			"openstack_compute_volume_attach_v2":                   resourceComputeVolumeAttachV2(),
+			"openstack_containerinfra_nodegroup_v1":                resourceContainerInfraNodeGroupV1(),
+			"openstack_containerinfra_clustertemplate_v1":          resourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_cluster_v1":                  resourceContainerInfraClusterV1(),
+			"openstack_db_instance_v1":                             resourceDatabaseInstanceV1(),
+			"openstack_db_user_v1":                                 resourceDatabaseUserV1(),
+			"openstack_db_configuration_v1":                        resourceDatabaseConfigurationV1(),
+			"openstack_db_database_v1":                             resourceDatabaseDatabaseV1(),
+			"openstack_dns_recordset_v2":                           resourceDNSRecordSetV2(),
+			"openstack_dns_zone_v2":                                resourceDNSZoneV2(),
+			"openstack_dns_zone_share_v2":                          resourceDNSZoneShareV2(),
+			"openstack_dns_transfer_request_v2":                    resourceDNSTransferRequestV2(),
+			"openstack_dns_transfer_accept_v2":                     resourceDNSTransferAcceptV2(),
+			"openstack_dns_quota_v2":                               resourceDNSQuotaV2(),
+			"openstack_dns_tsigkey_v2":                             resourceDNSTSIGKeyV2(),
+			"openstack_dns_tld_v2":                                 resourceDNSTLDV2(),
+			"openstack_dns_blacklist_v2":                           resourceDNSBlacklistV2(),
+			"openstack_dns_floatingip_ptr_v2":                      resourceDNSFloatingIPPTRV2(),
+			"openstack_fw_group_v2":                                resourceFWGroupV2(),
+			"openstack_fw_policy_v2":                               resourceFWPolicyV2(),
+			"openstack_fw_rule_v2":                                 resourceFWRuleV2(),
+			"openstack_identity_endpoint_v3":                       resourceIdentityEndpointV3(),
+			"openstack_identity_federation_provider_v3":            resourceIdentityFederationProviderV3(),
+			"openstack_identity_federation_mapping_v3":             resourceIdentityFederationMappingV3(),
+			"openstack_identity_federation_protocol_v3":            resourceIdentityFederationProtocolV3(),
+			"openstack_identity_project_v3":                        resourceIdentityProjectV3(),
+			"openstack_identity_role_v3":                           resourceIdentityRoleV3(),
+			"openstack_identity_role_assignment_v3":                resourceIdentityRoleAssignmentV3(),
+			"openstack_identity_inherit_role_assignment_v3":        resourceIdentityInheritRoleAssignmentV3(),
+			"openstack_identity_service_v3":                        resourceIdentityServiceV3(),
+			"openstack_identity_user_v3":                           resourceIdentityUserV3(),
+			"openstack_identity_user_membership_v3":                resourceIdentityUserMembershipV3(),
+			"openstack_identity_domain_v3":                         resourceIdentityDomainV3(),
+			"openstack_identity_group_v3":                          resourceIdentityGroupV3(),
+			"openstack_identity_application_credential_v3":         resourceIdentityApplicationCredentialV3(),
+			"openstack_identity_ec2_credential_v3":                 resourceIdentityEc2CredentialV3(),
+			"openstack_identity_credential_v3":                     resourceIdentityCredentialV3(),
+			"openstack_identity_trust_v3":                          resourceIdentityTrustV3(),
+			"openstack_identity_registered_limit_v3":               resourceIdentityRegisteredLimitV3(),
+			"openstack_identity_limit_v3":                          resourceIdentityLimitV3(),
+			"openstack_images_image_v2":                            resourceImagesImageV2(),
+			"openstack_images_image_access_v2":                     resourceImagesImageAccessV2(),
+			"openstack_images_image_access_accept_v2":              resourceImagesImageAccessAcceptV2(),
+			"openstack_lb_flavor_v2":                               resourceLoadBalancerFlavorV2(),
+			"openstack_lb_flavorprofile_v2":                        resourceLoadBalancerFlavorProfileV2(),
+			"openstack_lb_availability_zone_v2":                    resourceLoadBalancerAvailabilityZoneV2(),
+			"openstack_lb_availability_zone_profile_v2":            resourceLoadBalancerAvailabilityZoneProfileV2(),
+			"openstack_lb_loadbalancer_v2":                         resourceLoadBalancerV2(),
+			"openstack_lb_listener_v2":                             resourceListenerV2(),
+			"openstack_lb_pool_v2":                                 resourcePoolV2(),
+			"openstack_lb_member_v2":                               resourceMemberV2(),
+			"openstack_lb_members_v2":                              resourceMembersV2(),
+			"openstack_lb_monitor_v2":                              resourceMonitorV2(),
+			"openstack_lb_l7policy_v2":                             resourceL7PolicyV2(),
+			"openstack_lb_l7rule_v2":                               resourceL7RuleV2(),
+			"openstack_lb_quota_v2":                                resourceLoadBalancerQuotaV2(),
+			"openstack_networking_bgp_speaker_v2":                  resourceNetworkingBGPSpeakerV2(),
+			"openstack_networking_bgp_peer_v2":                     resourceNetworkingBGPPeerV2(),
+			"openstack_networking_floatingip_v2":                   resourceNetworkingFloatingIPV2(),
+			"openstack_networking_floatingip_associate_v2":         resourceNetworkingFloatingIPAssociateV2(),
+			"openstack_networking_network_v2":                      resourceNetworkingNetworkV2(),
+			"openstack_networking_port_v2":                         resourceNetworkingPortV2(),
+			"openstack_networking_rbac_policy_v2":                  resourceNetworkingRBACPolicyV2(),
+			"openstack_networking_port_secgroup_associate_v2":      resourceNetworkingPortSecGroupAssociateV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":     resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":        resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2":   resourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_qos_minimum_packet_rate_rule_v2": resourceNetworkingQoSMinimumPacketRateRuleV2(),
+			"openstack_networking_qos_packet_rate_limit_rule_v2":   resourceNetworkingQoSPacketRateLimitRuleV2(),
+			"openstack_networking_qos_policy_v2":                   resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_quota_v2":                        resourceNetworkingQuotaV2(),
+			"openstack_networking_router_v2":                       resourceNetworkingRouterV2(),
+			"openstack_networking_router_interface_v2":             resourceNetworkingRouterInterfaceV2(),
+			"openstack_networking_router_route_v2":                 resourceNetworkingRouterRouteV2(),
+			"openstack_networking_router_routes_v2":                resourceNetworkingRouterRoutesV2(),
+			"openstack_networking_secgroup_v2":                     resourceNetworkingSecGroupV2(),
+			"openstack_networking_secgroup_rule_v2":                resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_address_group_v2":                resourceNetworkingAddressGroupV2(),
+			"openstack_networking_subnet_v2":                       resourceNetworkingSubnetV2(),
+			"openstack_networking_subnet_route_v2":                 resourceNetworkingSubnetRouteV2(),
+			"openstack_networking_subnetpool_v2":                   resourceNetworkingSubnetPoolV2(),
+			"openstack_networking_addressscope_v2":                 resourceNetworkingAddressScopeV2(),
+			"openstack_networking_trunk_v2":                        resourceNetworkingTrunkV2(),
+			"openstack_networking_portforwarding_v2":               resourceNetworkingPortForwardingV2(),
+			"openstack_networking_local_ip_v2":                     resourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_association_v2":         resourceNetworkingLocalIPAssociationV2(),
+			"openstack_networking_segment_v2":                      resourceNetworkingSegmentV2(),
+			"openstack_networking_segment_range_v2":                resourceNetworkingSegmentRangeV2(),
+			"openstack_networking_log_v2":                          resourceNetworkingLogV2(),
+			"openstack_networking_metering_label_v2":               resourceNetworkingMeteringLabelV2(),
+			"openstack_networking_metering_label_rule_v2":          resourceNetworkingMeteringLabelRuleV2(),
+			"openstack_objectstorage_account_v1":                   resourceObjectStorageAccountV1(),
+			"openstack_objectstorage_container_v1":                 resourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                    resourceObjectStorageObjectV1(),
+			"openstack_objectstorage_tempurl_v1":                   resourceObjectstorageTempurlV1(),
+			"openstack_orchestration_stack_v1":                     resourceOrchestrationStackV1(),
+			"openstack_taas_tap_mirror_v2":                         resourceTapMirrorV2(),
+			"openstack_vpnaas_ipsec_policy_v2":                     resourceIPSecPolicyV2(),
+			"openstack_vpnaas_service_v2":                          resourceServiceV2(),
+			"openstack_vpnaas_ike_policy_v2":                       resourceIKEPolicyV2(),
+			"openstack_vpnaas_endpoint_group_v2":                   resourceEndpointGroupV2(),
+			"openstack_vpnaas_site_connection_v2":                  resourceSiteConnectionV2(),
+			"openstack_sharedfilesystem_securityservice_v2":        resourceSharedFilesystemSecurityServiceV2(),
+			"openstack_sharedfilesystem_sharenetwork_v2":           resourceSharedFilesystemShareNetworkV2(),
+			"openstack_sharedfilesystem_sharenetwork_subnet_v2":    resourceSharedFilesystemShareNetworkSubnetV2(),
+			"openstack_sharedfilesystem_share_v2":                  resourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_share_access_v2":           resourceSharedFilesystemShareAccessV2(),
+			"openstack_sharedfilesystem_snapshot_v2":               resourceSharedFilesystemSnapshotV2(),
+			"openstack_sharedfilesystem_share_type_v2":             resourceSharedFilesystemShareTypeV2(),
+			"openstack_sharedfilesystem_share_type_access_v2":      resourceSharedFilesystemShareTypeAccessV2(),
+			"openstack_sharedfilesystem_share_replica_v2":          resourceSharedFilesystemShareReplicaV2(),
+			"openstack_sharedfilesystem_share_group_v2":            resourceSharedFilesystemShareGroupV2(),
+			"openstack_keymanager_secret_v1":                       resourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                    resourceKeyManagerContainerV1(),
+			"openstack_keymanager_order_v1":                        resourceKeyManagerOrderV1(),
+			"openstack_bgpvpn_v2":                                  resourceBGPVPNV2(),
+			"openstack_bgpvpn_network_associate_v2":                resourceBGPVPNNetworkAssociateV2(),
+			"openstack_bgpvpn_router_associate_v2":                 resourceBGPVPNRouterAssociateV2(),
+			"openstack_bgpvpn_port_associate_v2":                   resourceBGPVPNPortAssociateV2(),
+			"openstack_workflow_cron_trigger_v2":                   resourceWorkflowCronTriggerV2(),
 		},
 	}
 
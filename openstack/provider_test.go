@@ -44,6 +44,9 @@ var (
 	osKeymanagerEnvironment      = os.Getenv("OS_KEYMANAGER_ENVIRONMENT")
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
+	osLocalIPEnvironment         = os.Getenv("OS_LOCAL_IP_ENVIRONMENT")
+	osNetworkingLogEnvironment   = os.Getenv("OS_NETWORKING_LOG_ENVIRONMENT")
+	osMeteringEnvironment        = os.Getenv("OS_METERING_ENVIRONMENT")
 	osTaaSEnvironment            = os.Getenv("OS_TAAS_ENVIRONMENT")
 	osWorkflowEnvironment        = os.Getenv("OS_WORKFLOW_ENVIRONMENT")
 	osMagnumHTTPProxy            = os.Getenv("OS_MAGNUM_HTTP_PROXY")
@@ -229,6 +232,30 @@ func testAccPreCheckPortForwarding(t *testing.T) {
 	}
 }
 
+func testAccPreCheckLocalIP(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osLocalIPEnvironment == "" {
+		t.Skip("This environment does not support 'local_ip' extension tests")
+	}
+}
+
+func testAccPreCheckNetworkingLog(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osNetworkingLogEnvironment == "" {
+		t.Skip("This environment does not support 'logging' extension tests")
+	}
+}
+
+func testAccPreCheckMetering(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osMeteringEnvironment == "" {
+		t.Skip("This environment does not support 'metering' extension tests")
+	}
+}
+
 func testAccPreCheckTaas(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
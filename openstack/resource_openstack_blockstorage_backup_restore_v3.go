@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageBackupRestoreV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageBackupRestoreV3Create,
+		ReadContext:   resourceBlockStorageBackupRestoreV3Read,
+		DeleteContext: resourceBlockStorageBackupRestoreV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"volume_id"},
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupRestoreV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	backupID := d.Get("backup_id").(string)
+	restoreOpts := backups.RestoreOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_restore_v3 restore options for backup %s: %#v", backupID, restoreOpts)
+
+	r, err := backups.RestoreFromBackup(ctx, blockStorageClient, backupID, restoreOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error restoring openstack_blockstorage_backup_v3 %s: %s", backupID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", r.BackupID, r.VolumeID))
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating", "restoring-backup"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(ctx, blockStorageClient, r.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to be restored from backup %s: %s", r.VolumeID, backupID, err)
+	}
+
+	stateConf = &retry.StateChangeConf{
+		Pending:    []string{"restoring"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(ctx, blockStorageClient, backupID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to become available: %s", backupID, err)
+	}
+
+	return resourceBlockStorageBackupRestoreV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageBackupRestoreV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	backupID, volumeID, err := parsePairedIDs(d.Id(), "openstack_blockstorage_backup_restore_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	v, err := volumes.Get(ctx, blockStorageClient, volumeID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_restore_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_restore_v3 %s volume: %#v", d.Id(), v)
+
+	d.Set("backup_id", backupID)
+	d.Set("volume_id", v.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageBackupRestoreV3Delete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	// Restoring a backup is a one-off action. The restored volume is not
+	// deleted together with this resource.
+	log.Printf("[DEBUG] Removing openstack_blockstorage_backup_restore_v3 %s from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,328 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageBackupV3Create,
+		ReadContext:   resourceBlockStorageBackupV3Read,
+		UpdateContext: resourceBlockStorageBackupV3Update,
+		DeleteContext: resourceBlockStorageBackupV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"is_incremental": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := backups.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		SnapshotID:  d.Get("snapshot_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Container:   d.Get("container").(string),
+		Incremental: d.Get("incremental").(bool),
+		Force:       d.Get("force").(bool),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		bumpClientMicroversion(blockStorageClient, blockstorageV3BackupMetadataMicroversion)
+		createOpts.Metadata = expandToMapStringString(v.(map[string]any))
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		bumpClientMicroversion(blockStorageClient, blockstorageV3BackupAZMicroversion)
+		createOpts.AvailabilityZone = v.(string)
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 create options: %#v", createOpts)
+
+	b, err := backups.Create(ctx, blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_backup_v3: %s", err)
+	}
+
+	d.SetId(b.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(ctx, blockStorageClient, b.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to become ready: %s", b.ID, err)
+	}
+
+	return resourceBlockStorageBackupV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageBackupV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// Backup metadata is only returned starting with microversion 3.43
+	// and the availability zone starting with microversion 3.51, so the
+	// microversion is only bumped when these fields are managed.
+	if _, ok := d.GetOk("metadata"); ok {
+		bumpClientMicroversion(blockStorageClient, blockstorageV3BackupMetadataMicroversion)
+	}
+
+	if _, ok := d.GetOk("availability_zone"); ok {
+		bumpClientMicroversion(blockStorageClient, blockstorageV3BackupAZMicroversion)
+	}
+
+	b, err := backups.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil && blockStorageClient.Microversion != "" && gophercloud.ResponseCodeIs(err, http.StatusNotAcceptable) {
+		log.Printf("[WARN] Microversion %s is not supported for openstack_blockstorage_backup_v3 %s, retrying without it: %s",
+			blockStorageClient.Microversion, d.Id(), err)
+
+		blockStorageClient.Microversion = ""
+		b, err = backups.Get(ctx, blockStorageClient, d.Id()).Extract()
+	}
+
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_v3 %s: %#v", d.Id(), b)
+
+	d.Set("volume_id", b.VolumeID)
+	d.Set("snapshot_id", b.SnapshotID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("container", b.Container)
+	// Without microversion 3.43 the metadata is not returned at all, so
+	// the configured metadata is kept.
+	if b.Metadata != nil {
+		d.Set("metadata", flattenBlockStorageBackupV3Metadata(b))
+	}
+	d.Set("size", b.Size)
+	d.Set("status", b.Status)
+	d.Set("object_count", b.ObjectCount)
+	d.Set("is_incremental", b.IsIncremental)
+	d.Set("has_dependent_backups", b.HasDependentBackups)
+	d.Set("created_at", b.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", b.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if b.AvailabilityZone != nil {
+		d.Set("availability_zone", *b.AvailabilityZone)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageBackupV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3BackupUpdateMicroversion
+
+	var hasChange bool
+
+	var updateOpts backupUpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("metadata") {
+		hasChange = true
+		metadata := expandToMapStringString(d.Get("metadata").(map[string]any))
+		updateOpts.Metadata = &metadata
+
+		bumpClientMicroversion(blockStorageClient, blockstorageV3BackupMetadataMicroversion)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_backup_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = backups.Update(ctx, blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_backup_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageBackupV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageBackupV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	b, err := backups.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_v3"))
+	}
+
+	// It's possible that this backup is already in a "deleting" state.
+	// If this is true, just move on. It'll eventually delete.
+	if b.Status != "deleting" {
+		if err := backups.Delete(ctx, blockStorageClient, d.Id()).ExtractErr(); err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_backup_v3"))
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(ctx, blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_backup_v3 %s to Delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageV3Backup_basic(t *testing.T) {
+	var backup backups.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			t.Skip("Currently Cinder Backup is not configured properly on GH-A devstack")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3BackupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists(t.Context(), "openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", "backup_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3BackupUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists(t.Context(), "openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", "backup_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "baz"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3BackupRemoveMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists(t.Context(), "openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_incremental(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			t.Skip("Currently Cinder Backup is not configured properly on GH-A devstack")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3BackupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupIncremental,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_2", "is_incremental", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_restore(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			t.Skip("Currently Cinder Backup is not configured properly on GH-A devstack")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3BackupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3BackupRestore,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_backup_restore_v3.restore_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_2", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_backup_restore_v3.restore_1", "backup_id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3BackupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_backup_v3" {
+				continue
+			}
+
+			_, err := backups.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Backup still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3BackupExists(ctx context.Context, n string, backup *backups.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		found, err := backups.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3BackupBasic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name        = "backup_1"
+  description = "first test backup"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  metadata = {
+    foo = "bar"
+  }
+}
+`
+
+const testAccBlockStorageV3BackupUpdate = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name        = "backup_1-updated"
+  description = "first test backup"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  metadata = {
+    foo = "baz"
+  }
+}
+`
+
+const testAccBlockStorageV3BackupRemoveMetadata = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name        = "backup_1-updated"
+  description = "first test backup"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+}
+`
+
+const testAccBlockStorageV3BackupIncremental = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name      = "backup_1"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_2" {
+  name        = "backup_2"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  incremental = true
+
+  depends_on = [openstack_blockstorage_backup_v3.backup_1]
+}
+`
+
+const testAccBlockStorageV3BackupRestore = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name      = "backup_1"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name = "volume_2"
+  size = 1
+}
+
+resource "openstack_blockstorage_backup_restore_v3" "restore_1" {
+  backup_id = openstack_blockstorage_backup_v3.backup_1.id
+  volume_id = openstack_blockstorage_volume_v3.volume_2.id
+}
+`
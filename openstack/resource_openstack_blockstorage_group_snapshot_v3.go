@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageGroupSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageGroupSnapshotV3Create,
+		ReadContext:   resourceBlockStorageGroupSnapshotV3Read,
+		DeleteContext: resourceBlockStorageGroupSnapshotV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"group_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageGroupSnapshotV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupSnapshotMicroversion
+
+	createOpts := blockStorageGroupSnapshotV3CreateOpts{
+		GroupID:     d.Get("group_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_group_snapshot_v3 create options: %#v", createOpts)
+
+	s, err := blockStorageGroupSnapshotV3Create(ctx, blockStorageClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_group_snapshot_v3: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageGroupSnapshotV3StateRefreshFunc(ctx, blockStorageClient, s.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_group_snapshot_v3 %s to become ready: %s", s.ID, err)
+	}
+
+	return resourceBlockStorageGroupSnapshotV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageGroupSnapshotV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupSnapshotMicroversion
+
+	s, err := blockStorageGroupSnapshotV3Get(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_group_snapshot_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_group_snapshot_v3 %s: %#v", d.Id(), s)
+
+	d.Set("group_id", s.GroupID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("group_type_id", s.GroupTypeID)
+	d.Set("status", s.Status)
+	d.Set("created_at", s.CreatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageGroupSnapshotV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupSnapshotMicroversion
+
+	err = blockStorageGroupSnapshotV3Delete(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_group_snapshot_v3"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageGroupSnapshotV3StateRefreshFunc(ctx, blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_group_snapshot_v3 %s to Delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageGroupSnapshotV3_basic(t *testing.T) {
+	var groupSnapshot blockStorageGroupSnapshotV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageGroupSnapshotV3Destroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageGroupSnapshotV3Basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageGroupSnapshotV3Exists(t.Context(), "openstack_blockstorage_group_snapshot_v3.group_snapshot_1", &groupSnapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_snapshot_v3.group_snapshot_1", "name", "group_snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_snapshot_v3.group_snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_group_snapshot_v3.group_snapshot_1", "group_id",
+						"openstack_blockstorage_group_v3.group_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_group_snapshot_v3.group_snapshot_1", "group_type_id",
+						"openstack_blockstorage_group_type_v3.group_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageGroupSnapshotV3Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupSnapshotMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_group_snapshot_v3" {
+				continue
+			}
+
+			_, err := blockStorageGroupSnapshotV3Get(ctx, blockStorageClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Group snapshot still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageGroupSnapshotV3Exists(ctx context.Context, n string, groupSnapshot *blockStorageGroupSnapshotV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupSnapshotMicroversion
+
+		found, err := blockStorageGroupSnapshotV3Get(ctx, blockStorageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Group snapshot not found")
+		}
+
+		*groupSnapshot = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageGroupSnapshotV3Basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_group_snapshot_v3" "group_snapshot_1" {
+  name     = "group_snapshot_1"
+  group_id = openstack_blockstorage_group_v3.group_1.id
+
+  depends_on = [openstack_blockstorage_volume_v3.volume_1]
+}
+`, testAccBlockStorageGroupV3Volume("openstack_blockstorage_group_v3.group_1.id"))
+}
@@ -0,0 +1,204 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageGroupTypeV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageGroupTypeV3Create,
+		ReadContext:   resourceBlockStorageGroupTypeV3Read,
+		UpdateContext: resourceBlockStorageGroupTypeV3Update,
+		DeleteContext: resourceBlockStorageGroupTypeV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"group_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageGroupTypeV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+	name := d.Get("name").(string)
+	groupSpecs := d.Get("group_specs").(map[string]any)
+	createOpts := blockStorageGroupTypeV3CreateOpts{
+		Name:        name,
+		Description: d.Get("description").(string),
+		GroupSpecs:  expandToMapStringString(groupSpecs),
+	}
+
+	if v, ok := getOkExists(d, "is_public"); ok {
+		isPublic := v.(bool)
+		createOpts.IsPublic = &isPublic
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_group_type_v3 create options: %#v", createOpts)
+
+	gt, err := blockStorageGroupTypeV3Create(ctx, blockStorageClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_group_type_v3 %s: %s", name, err)
+	}
+
+	d.SetId(gt.ID)
+
+	return resourceBlockStorageGroupTypeV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageGroupTypeV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+	gt, err := blockStorageGroupTypeV3Get(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_group_type_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_group_type_v3 %s: %#v", d.Id(), gt)
+
+	d.Set("name", gt.Name)
+	d.Set("description", gt.Description)
+	d.Set("is_public", gt.IsPublic)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("group_specs", gt.GroupSpecs); err != nil {
+		log.Printf("[WARN] Unable to set group_specs for openstack_blockstorage_group_type_v3 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageGroupTypeV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+	hasChange := false
+
+	var updateOpts blockStorageGroupTypeV3UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_group_type_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		err = blockStorageGroupTypeV3Update(ctx, blockStorageClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_group_type_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("group_specs") {
+		oldGS, newGS := d.GetChange("group_specs")
+		newGSRaw := newGS.(map[string]any)
+
+		// Delete the group specs which are not configured anymore.
+		for oldKey := range oldGS.(map[string]any) {
+			if _, ok := newGSRaw[oldKey]; ok {
+				continue
+			}
+
+			if err := blockStorageGroupTypeV3DeleteGroupSpec(ctx, blockStorageClient, d.Id(), oldKey); err != nil {
+				return diag.Errorf("Error deleting group_spec %s from openstack_blockstorage_group_type_v3 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+
+		// Create or update the remaining group specs.
+		if len(newGSRaw) > 0 {
+			groupSpecs := expandToMapStringString(newGSRaw)
+
+			if err := blockStorageGroupTypeV3CreateGroupSpecs(ctx, blockStorageClient, d.Id(), groupSpecs); err != nil {
+				return diag.Errorf("Error creating group_specs for openstack_blockstorage_group_type_v3 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceBlockStorageGroupTypeV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageGroupTypeV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+	err = blockStorageGroupTypeV3Delete(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_group_type_v3"))
+	}
+
+	return nil
+}
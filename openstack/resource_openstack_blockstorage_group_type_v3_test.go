@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageGroupTypeV3_basic(t *testing.T) {
+	var groupType blockStorageGroupTypeV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageGroupTypeV3Destroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageGroupTypeV3Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageGroupTypeV3Exists(t.Context(), "openstack_blockstorage_group_type_v3.group_type_1", &groupType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "name", "foo"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "description", "foo"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "group_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "group_specs.consistent_group_snapshot_enabled", "<is> True"),
+				),
+			},
+			{
+				Config: testAccBlockStorageGroupTypeV3Update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageGroupTypeV3Exists(t.Context(), "openstack_blockstorage_group_type_v3.group_type_1", &groupType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "name", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "description", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "is_public", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "group_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_type_v3.group_type_1", "group_specs.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageGroupTypeV3Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_group_type_v3" {
+				continue
+			}
+
+			_, err := blockStorageGroupTypeV3Get(ctx, blockStorageClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Group type still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageGroupTypeV3Exists(ctx context.Context, n string, groupType *blockStorageGroupTypeV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupTypeMicroversion
+
+		found, err := blockStorageGroupTypeV3Get(ctx, blockStorageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Group type not found")
+		}
+
+		*groupType = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageGroupTypeV3Basic = `
+resource "openstack_blockstorage_group_type_v3" "group_type_1" {
+  name        = "foo"
+  description = "foo"
+  is_public   = true
+
+  group_specs = {
+    consistent_group_snapshot_enabled = "<is> True"
+  }
+}
+`
+
+const testAccBlockStorageGroupTypeV3Update = `
+resource "openstack_blockstorage_group_type_v3" "group_type_1" {
+  name        = "bar"
+  description = "bar"
+  is_public   = false
+
+  group_specs = {
+    foo = "bar"
+  }
+}
+`
@@ -0,0 +1,227 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBlockStorageGroupV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageGroupV3Create,
+		ReadContext:   resourceBlockStorageGroupV3Read,
+		UpdateContext: resourceBlockStorageGroupV3Update,
+		DeleteContext: resourceBlockStorageGroupV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"group_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"volume_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageGroupV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+	createOpts := blockStorageGroupV3CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		GroupType:        d.Get("group_type").(string),
+		VolumeTypes:      expandToStringSlice(d.Get("volume_types").([]any)),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_group_v3 create options: %#v", createOpts)
+
+	g, err := blockStorageGroupV3Create(ctx, blockStorageClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_group_v3: %s", err)
+	}
+
+	d.SetId(g.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageGroupV3StateRefreshFunc(ctx, blockStorageClient, g.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_group_v3 %s to become ready: %s", g.ID, err)
+	}
+
+	return resourceBlockStorageGroupV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageGroupV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+	g, err := blockStorageGroupV3Get(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_group_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_group_v3 %s: %#v", d.Id(), g)
+
+	d.Set("name", g.Name)
+	d.Set("description", g.Description)
+	d.Set("group_type", g.GroupType)
+	d.Set("volume_types", g.VolumeTypes)
+	d.Set("availability_zone", g.AvailabilityZone)
+	d.Set("status", g.Status)
+	d.Set("created_at", g.CreatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageGroupV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+	var hasChange bool
+
+	var updateOpts blockStorageGroupV3UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_group_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		err = blockStorageGroupV3Update(ctx, blockStorageClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_group_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageGroupV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageGroupV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+	err = blockStorageGroupV3Delete(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_group_v3"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageGroupV3StateRefreshFunc(ctx, blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_group_v3 %s to Delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
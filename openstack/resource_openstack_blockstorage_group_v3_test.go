@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageGroupV3_basic(t *testing.T) {
+	var group blockStorageGroupV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageGroupV3Destroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageGroupV3Basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageGroupV3Exists(t.Context(), "openstack_blockstorage_group_v3.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_v3.group_1", "name", "group_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_v3.group_1", "status", "available"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_group_v3.group_1", "group_type",
+						"openstack_blockstorage_group_type_v3.group_type_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_group_v3.group_1", "volume_types.0",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+				),
+			},
+			{
+				Config: testAccBlockStorageGroupV3Update(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageGroupV3Exists(t.Context(), "openstack_blockstorage_group_v3.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_v3.group_1", "name", "group_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_group_v3.group_1", "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageGroupV3_volume(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageGroupV3Destroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageGroupV3Volume(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists(t.Context(), "openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "group_id", ""),
+				),
+			},
+			{
+				Config: testAccBlockStorageGroupV3Volume("openstack_blockstorage_group_v3.group_1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists(t.Context(), "openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_1", "group_id",
+						"openstack_blockstorage_group_v3.group_1", "id"),
+				),
+			},
+			{
+				Config: testAccBlockStorageGroupV3Volume(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists(t.Context(), "openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "group_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageGroupV3Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_group_v3" {
+				continue
+			}
+
+			_, err := blockStorageGroupV3Get(ctx, blockStorageClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Group still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageGroupV3Exists(ctx context.Context, n string, group *blockStorageGroupV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+		found, err := blockStorageGroupV3Get(ctx, blockStorageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Group not found")
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageGroupV3Types = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "group_volume_type_1"
+}
+
+resource "openstack_blockstorage_group_type_v3" "group_type_1" {
+  name = "group_type_1"
+
+  group_specs = {
+    consistent_group_snapshot_enabled = "<is> True"
+  }
+}
+`
+
+func testAccBlockStorageGroupV3Basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_group_v3" "group_1" {
+  name         = "group_1"
+  group_type   = openstack_blockstorage_group_type_v3.group_type_1.id
+  volume_types = [openstack_blockstorage_volume_type_v3.volume_type_1.id]
+}
+`, testAccBlockStorageGroupV3Types)
+}
+
+func testAccBlockStorageGroupV3Update() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_group_v3" "group_1" {
+  name         = "group_1_updated"
+  description  = "updated"
+  group_type   = openstack_blockstorage_group_type_v3.group_type_1.id
+  volume_types = [openstack_blockstorage_volume_type_v3.volume_type_1.id]
+}
+`, testAccBlockStorageGroupV3Types)
+}
+
+func testAccBlockStorageGroupV3Volume(groupID string) string {
+	if groupID == "" {
+		groupID = `""`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_group_v3" "group_1" {
+  name         = "group_1"
+  group_type   = openstack_blockstorage_group_type_v3.group_type_1.id
+  volume_types = [openstack_blockstorage_volume_type_v3.volume_type_1.id]
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name        = "volume_1"
+  size        = 1
+  volume_type = openstack_blockstorage_volume_type_v3.volume_type_1.name
+  group_id    = %s
+}
+`, testAccBlockStorageGroupV3Types, groupID)
+}
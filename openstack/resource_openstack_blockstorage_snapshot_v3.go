@@ -0,0 +1,232 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageSnapshotV3Create,
+		ReadContext:   resourceBlockStorageSnapshotV3Read,
+		UpdateContext: resourceBlockStorageSnapshotV3Update,
+		DeleteContext: resourceBlockStorageSnapshotV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSnapshotV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]any)
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Force:       d.Get("force").(bool),
+		Metadata:    expandToMapStringString(metadata),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 create options: %#v", createOpts)
+
+	s, err := snapshots.Create(ctx, blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_snapshot_v3: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(ctx, blockStorageClient, s.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_snapshot_v3 %s to become ready: %s", s.ID, err)
+	}
+
+	return resourceBlockStorageSnapshotV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	s, err := snapshots.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_snapshot_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_snapshot_v3 %s: %#v", d.Id(), s)
+
+	d.Set("volume_id", s.VolumeID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("metadata", s.Metadata)
+	d.Set("size", s.Size)
+	d.Set("status", s.Status)
+	d.Set("created_at", s.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", s.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageSnapshotV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+		updateOpts := snapshots.UpdateOpts{
+			Name:        &name,
+			Description: &description,
+		}
+
+		_, err = snapshots.Update(ctx, blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_snapshot_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		metadataOpts := snapshots.UpdateMetadataOpts{
+			Metadata: d.Get("metadata").(map[string]any),
+		}
+
+		_, err = snapshots.UpdateMetadata(ctx, blockStorageClient, d.Id(), metadataOpts).ExtractMetadata()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_blockstorage_snapshot_v3 %s metadata: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageSnapshotV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	s, err := snapshots.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_snapshot_v3"))
+	}
+
+	// It's possible that this snapshot is already in a "deleting" state.
+	// If this is true, just move on. It'll eventually delete.
+	if s.Status != "deleting" {
+		if err := snapshots.Delete(ctx, blockStorageClient, d.Id()).ExtractErr(); err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_snapshot_v3"))
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(ctx, blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_blockstorage_snapshot_v3 %s to Delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageV3Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3SnapshotDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3SnapshotBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists(t.Context(), "openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3SnapshotUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists(t.Context(), "openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", "updated snapshot"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_snapshot_v3" {
+				continue
+			}
+
+			_, err := snapshots.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Snapshot still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3SnapshotExists(ctx context.Context, n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		found, err := snapshots.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3SnapshotBasic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name        = "snapshot_1"
+  description = "first test snapshot"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  metadata = {
+    foo = "bar"
+  }
+}
+`
+
+const testAccBlockStorageV3SnapshotUpdate = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name        = "snapshot_1-updated"
+  description = "updated snapshot"
+  volume_id   = openstack_blockstorage_volume_v3.volume_1.id
+  metadata = {
+    foo = "baz"
+  }
+}
+`
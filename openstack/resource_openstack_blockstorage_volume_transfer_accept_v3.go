@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/transfers"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferAcceptV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageVolumeTransferAcceptV3Create,
+		ReadContext:   resourceBlockStorageVolumeTransferAcceptV3Read,
+		DeleteContext: resourceBlockStorageVolumeTransferAcceptV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"transfer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auth_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	acceptOpts := transfers.AcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+
+	log.Printf("[DEBUG] Accepting openstack_blockstorage_volume_transfer_v3 %s", transferID)
+
+	t, err := transfers.Accept(ctx, blockStorageClient, transferID, acceptOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error accepting openstack_blockstorage_volume_transfer_v3 %s: %s", transferID, err)
+	}
+
+	d.SetId(t.ID)
+	d.Set("volume_id", t.VolumeID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"awaiting-transfer"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(ctx, blockStorageClient, t.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to become available: %s", t.VolumeID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferAcceptV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// The transfer itself is consumed by the accept, so only check that
+	// the transferred volume is still there.
+	v, err := volumes.Get(ctx, blockStorageClient, d.Get("volume_id").(string)).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_transfer_accept_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_transfer_accept_v3 %s volume: %#v", d.Id(), v)
+
+	d.Set("volume_id", v.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Delete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	// An accepted transfer can't be reverted. The volume stays in the
+	// accepting project.
+	log.Printf("[DEBUG] Removing openstack_blockstorage_volume_transfer_accept_v3 %s from state", d.Id())
+
+	return nil
+}
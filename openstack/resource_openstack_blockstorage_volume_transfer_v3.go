@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/transfers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlockStorageVolumeTransferV3Create,
+		ReadContext:   resourceBlockStorageVolumeTransferV3Read,
+		DeleteContext: resourceBlockStorageVolumeTransferV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBlockStorageVolumeTransferV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"auth_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	createOpts := transfers.CreateOpts{
+		VolumeID: volumeID,
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 create options: %#v", createOpts)
+
+	t, err := transfers.Create(ctx, blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_blockstorage_volume_transfer_v3: %s", err)
+	}
+
+	d.SetId(t.ID)
+
+	// The auth key is returned only once.
+	d.Set("auth_key", t.AuthKey)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"available"},
+		Target:     []string{"awaiting-transfer"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(ctx, blockStorageClient, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to await transfer: %s", volumeID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferV3Read(ctx, d, meta)
+}
+
+func resourceBlockStorageVolumeTransferV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	t, err := transfers.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		// An accepted transfer is removed from the API. Keep the resource
+		// in the state, otherwise it would be recreated for a volume which
+		// may already belong to another project.
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 %s was already accepted or cancelled", d.Id())
+
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving openstack_blockstorage_volume_transfer_v3 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_transfer_v3 %s: %#v", d.Id(), t)
+
+	d.Set("volume_id", t.VolumeID)
+	d.Set("name", t.Name)
+	d.Set("created_at", t.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = transfers.Delete(ctx, blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_transfer_v3"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"awaiting-transfer"},
+		Target:     []string{"available", "deleted"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(ctx, blockStorageClient, d.Get("volume_id").(string)),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_blockstorage_volume_v3 %s to become available: %s", d.Get("volume_id").(string), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferV3Import(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	blockStorageClient, err := config.BlockStorageV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenStack block storage client: %w", err)
+	}
+
+	// Make sure the transfer exists, because a missing transfer is
+	// not removed from the state on read.
+	_, err = transfers.Get(ctx, blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving openstack_blockstorage_volume_transfer_v3 %s: %w", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
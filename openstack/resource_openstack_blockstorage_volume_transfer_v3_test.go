@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/transfers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTransfer_basic(t *testing.T) {
+	var transfer transfers.Transfer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3VolumeTransferDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTransferExists(t.Context(), "openstack_blockstorage_volume_transfer_v3.transfer_1", &transfer),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "name", "transfer_1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "auth_key"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3VolumeTransfer_accept(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckBlockStorageV3VolumeTransferDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferAccept,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_accept_v3.accept_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+			{
+				// The transfer request is consumed now, the plan must stay empty.
+				Config:   testAccBlockStorageV3VolumeTransferAccept,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_blockstorage_volume_transfer_v3" {
+				continue
+			}
+
+			_, err := transfers.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Volume transfer still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3VolumeTransferExists(ctx context.Context, n string, transfer *transfers.Transfer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		blockStorageClient, err := config.BlockStorageV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %w", err)
+		}
+
+		found, err := transfers.Get(ctx, blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Volume transfer not found")
+		}
+
+		*transfer = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeTransferBasic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_transfer_v3" "transfer_1" {
+  name      = "transfer_1"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+`
+
+const testAccBlockStorageV3VolumeTransferAccept = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_transfer_v3" "transfer_1" {
+  name      = "transfer_1"
+  volume_id = openstack_blockstorage_volume_v3.volume_1.id
+}
+
+resource "openstack_blockstorage_volume_transfer_accept_v3" "accept_1" {
+  transfer_id = openstack_blockstorage_volume_transfer_v3.transfer_1.id
+  auth_key    = openstack_blockstorage_volume_transfer_v3.transfer_1.auth_key
+}
+`
@@ -124,6 +124,12 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"source_replica": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -252,6 +258,12 @@ func resourceBlockStorageVolumeV3Create(ctx context.Context, d *schema.ResourceD
 			"Error waiting for openstack_blockstorage_volume_v3 %s to become ready: %s", v.ID, err)
 	}
 
+	if groupID := d.Get("group_id").(string); groupID != "" {
+		if err := resourceBlockStorageVolumeV3UpdateGroup(ctx, d, blockStorageClient, "", groupID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceBlockStorageVolumeV3Read(ctx, d, meta)
 }
 
@@ -281,6 +293,16 @@ func resourceBlockStorageVolumeV3Read(ctx context.Context, d *schema.ResourceDat
 	d.Set("metadata", v.Metadata)
 	d.Set("region", GetRegion(d, config))
 
+	// The group membership is only exposed by a newer microversion.
+	blockStorageClient.Microversion = blockstorageV3GroupMicroversion
+
+	groupID, err := blockStorageVolumeV3GroupID(ctx, blockStorageClient, d.Id())
+	if err != nil {
+		return diag.Errorf("Error retrieving group_id for openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
+	}
+
+	d.Set("group_id", groupID)
+
 	if _, exists := d.GetOk("volume_retype_policy"); !exists {
 		d.Set("volume_retype_policy", "never")
 	}
@@ -397,6 +419,15 @@ func resourceBlockStorageVolumeV3Update(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error updating openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
 	}
 
+	if d.HasChange("group_id") {
+		oldGroupID, newGroupID := d.GetChange("group_id")
+
+		err = resourceBlockStorageVolumeV3UpdateGroup(ctx, d, blockStorageClient, oldGroupID.(string), newGroupID.(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceBlockStorageVolumeV3Read(ctx, d, meta)
 }
 
@@ -461,6 +492,13 @@ func resourceBlockStorageVolumeV3Delete(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	// A volume can't be deleted while it's a member of a group.
+	if groupID := d.Get("group_id").(string); groupID != "" {
+		if err := resourceBlockStorageVolumeV3UpdateGroup(ctx, d, blockStorageClient, groupID, "", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// It's possible that this volume was used as a boot device and is currently
 	// in a "deleting" state from when the instance was terminated.
 	// If this is true, just move on. It'll eventually delete.
@@ -514,3 +552,38 @@ func resourceBlockStorageVolumeV3Import(ctx context.Context, d *schema.ResourceD
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceBlockStorageVolumeV3UpdateGroup moves the volume from the group
+// oldGroupID to the group newGroupID and waits for both groups to settle.
+func resourceBlockStorageVolumeV3UpdateGroup(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, oldGroupID, newGroupID string, timeout time.Duration) error {
+	client.Microversion = blockstorageV3GroupMicroversion
+
+	log.Printf("[DEBUG] Moving openstack_blockstorage_volume_v3 %s from group %q to group %q", d.Id(), oldGroupID, newGroupID)
+
+	err := blockStorageGroupV3UpdateVolumes(ctx, client, d.Id(), oldGroupID, newGroupID)
+	if err != nil {
+		return fmt.Errorf("error updating group of openstack_blockstorage_volume_v3 %s: %w", d.Id(), err)
+	}
+
+	for _, groupID := range []string{oldGroupID, newGroupID} {
+		if groupID == "" {
+			continue
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"updating"},
+			Target:     []string{"available", "deleted"},
+			Refresh:    blockStorageGroupV3StateRefreshFunc(ctx, client, groupID),
+			Timeout:    timeout,
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("error waiting for openstack_blockstorage_group_v3 %s to become ready: %w", groupID, err)
+		}
+	}
+
+	return nil
+}
@@ -85,7 +85,6 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				// just stash the hash for state & diff comparisons
 				StateFunc: func(v any) string {
 					switch v := v.(type) {
@@ -213,7 +212,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"key_pair": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+			},
+			"rebuild_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"block_device": {
 				Type:     schema.TypeList,
@@ -371,10 +374,18 @@ func resourceComputeInstanceV2() *schema.Resource {
 				ForceNew: false,
 				Default:  "active",
 				ValidateFunc: validation.StringInSlice([]string{
-					"active", "shutoff", "shelved_offloaded", "paused",
+					"active", "shutoff", "shelved", "shelved_offloaded", "paused", "rescue",
 				}, true),
 				DiffSuppressFunc: suppressPowerStateDiffs,
 			},
+			"rescue_image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"unshelve_availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -423,6 +434,14 @@ func resourceComputeInstanceV2() *schema.Resource {
 			customdiff.ForceNewIfChange("flavor_name", func(_ context.Context, old, _, _ any) bool {
 				return old.(string) == ""
 			}),
+			// Changing the key pair or the user data requires a new instance,
+			// unless the instance is allowed to be rebuilt in place.
+			customdiff.ForceNewIf("key_pair", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+				return d.HasChange("key_pair") && !d.Get("rebuild_on_change").(bool)
+			}),
+			customdiff.ForceNewIf("user_data", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+				return d.HasChange("user_data") && !d.Get("rebuild_on_change").(bool)
+			}),
 			func(_ context.Context, d *schema.ResourceDiff, _ any) error {
 				currentState, _ := d.GetChange("power_state")
 				if currentState == "build" {
@@ -647,6 +666,22 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if strings.ToLower(vmState) == "shelved" || strings.ToLower(vmState) == "shelved_offloaded" {
+		offload := strings.ToLower(vmState) == "shelved_offloaded"
+
+		err = computeV2InstanceShelve(ctx, computeClient, d.Id(), offload, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if strings.ToLower(vmState) == "rescue" {
+		err = computeV2InstanceRescue(ctx, computeClient, d.Id(), d.Get("rescue_image_id").(string), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceComputeInstanceV2Read(ctx, d, meta)
 }
 
@@ -761,7 +796,7 @@ func resourceComputeInstanceV2Read(ctx context.Context, d *schema.ResourceData,
 	// Set the current power_state
 	currentStatus := strings.ToLower(server.Status)
 	switch currentStatus {
-	case "active", "shutoff", "error", "migrating", "shelved_offloaded", "shelved", "build", "paused":
+	case "active", "shutoff", "error", "migrating", "shelved_offloaded", "shelved", "build", "paused", "rescue":
 		d.Set("power_state", currentStatus)
 	default:
 		return diag.Errorf("Invalid power_state for instance %s: %s", d.Id(), server.Status)
@@ -808,27 +843,44 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		powerStateOld := powerStateOldRaw.(string)
 
 		powerStateNew := powerStateNewRaw.(string)
-		if strings.ToLower(powerStateNew) == "shelved_offloaded" {
-			err = servers.Shelve(ctx, computeClient, d.Id()).ExtractErr()
+
+		// A rescued instance has to be unrescued before any other power
+		// state change.
+		if strings.ToLower(powerStateOld) == "rescue" {
+			err = computeV2InstanceUnrescue(ctx, computeClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.Errorf("Error shelve OpenStack instance: %s", err)
+				return diag.FromErr(err)
 			}
 
-			shelveStateConf := &retry.StateChangeConf{
-				// Pending:    []string{"ACTIVE"},
-				Target:     []string{"SHELVED_OFFLOADED"},
-				Refresh:    ServerV2StateRefreshFunc(ctx, computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      0,
-				MinTimeout: 3 * time.Second,
-			}
+			powerStateOld = "active"
+		}
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to shelve", d.Id())
+		switch {
+		case strings.ToLower(powerStateNew) == "shelved_offloaded" && strings.ToLower(powerStateOld) == "shelved":
+			err = computeV2InstanceShelveOffload(ctx, computeClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		case strings.ToLower(powerStateNew) == "shelved" || strings.ToLower(powerStateNew) == "shelved_offloaded":
+			// An offloaded instance is already shelved, there is nothing to do
+			// when moving it back to "shelved".
+			if strings.ToLower(powerStateOld) != "shelved_offloaded" {
+				offload := strings.ToLower(powerStateNew) == "shelved_offloaded"
 
-			_, err = shelveStateConf.WaitForStateContext(ctx)
+				err = computeV2InstanceShelve(ctx, computeClient, d.Id(), offload, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		case strings.ToLower(powerStateOld) == "shelved" || strings.ToLower(powerStateOld) == "shelved_offloaded":
+			// A shelved instance has to be unshelved before it can be
+			// started, stopped or paused.
+			err = computeV2InstanceUnshelve(ctx, computeClient, d.Id(), computeV2InstanceUnshelveAvailabilityZone(d), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.Errorf("Error waiting for instance (%s) to become shelve: %s", d.Id(), err)
+				return diag.FromErr(err)
 			}
+
+			powerStateOld = "active"
 		}
 
 		if strings.ToLower(powerStateNew) == "paused" {
@@ -878,21 +930,12 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 
 		if strings.ToLower(powerStateNew) == "active" {
-			if strings.ToLower(powerStateOld) == "shelved" || strings.ToLower(powerStateOld) == "shelved_offloaded" {
-				unshelveOpt := &servers.UnshelveOpts{
-					AvailabilityZone: d.Get("availability_zone").(string),
-				}
-
-				err = servers.Unshelve(ctx, computeClient, d.Id(), unshelveOpt).ExtractErr()
-				if err != nil {
-					return diag.Errorf("Error unshelving OpenStack instance: %s", err)
-				}
-			} else if strings.ToLower(powerStateOld) == "paused" {
+			if strings.ToLower(powerStateOld) == "paused" {
 				err = servers.Unpause(ctx, computeClient, d.Id()).ExtractErr()
 				if err != nil {
 					return diag.Errorf("Error resuming OpenStack instance: %s", err)
 				}
-			} else if strings.ToLower(powerStateOld) != "build" {
+			} else if strings.ToLower(powerStateOld) != "build" && strings.ToLower(powerStateOld) != "active" {
 				err = servers.Start(ctx, computeClient, d.Id()).ExtractErr()
 				if err != nil {
 					return diag.Errorf("Error starting OpenStack instance: %s", err)
@@ -915,6 +958,13 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 				return diag.Errorf("Error waiting for instance (%s) to become active: %s", d.Id(), err)
 			}
 		}
+
+		if strings.ToLower(powerStateNew) == "rescue" {
+			err = computeV2InstanceRescue(ctx, computeClient, d.Id(), d.Get("rescue_image_id").(string), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange("metadata") {
@@ -1093,7 +1143,8 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	if d.HasChange("image_id") || d.HasChange("image_name") || d.HasChange("personality") {
+	if d.HasChange("image_id") || d.HasChange("image_name") || d.HasChange("personality") ||
+		d.HasChange("key_pair") || d.HasChange("user_data") {
 		var newImageID string
 
 		imageClient, err := config.ImageV2Client(ctx, GetRegion(d, config))
@@ -1122,6 +1173,35 @@ func resourceComputeInstanceV2Update(ctx context.Context, d *schema.ResourceData
 			Personality: resourceInstancePersonalityV2(d),
 		}
 
+		if d.Get("rebuild_on_change").(bool) {
+			opts := &ComputeInstanceRebuildOpts{
+				RebuildOpts: servers.RebuildOpts{
+					ImageRef:    newImageID,
+					Personality: resourceInstancePersonalityV2(d),
+					Metadata:    resourceInstanceMetadataV2(d),
+				},
+			}
+
+			// Nova keeps the current key pair and user data, unless they
+			// are passed explicitly. The user_data state only holds a hash,
+			// so it can only be passed when it has been changed.
+			if d.HasChange("key_pair") {
+				keyName := d.Get("key_pair").(string)
+				opts.KeyName = &keyName
+			}
+
+			if d.HasChange("user_data") {
+				userData := d.Get("user_data").(string)
+				opts.UserData = &userData
+			}
+
+			if opts.KeyName != nil || opts.UserData != nil {
+				bumpClientMicroversion(computeClient, computeV2InstanceRebuildWithKeyPairAndUserDataMicroversion)
+			}
+
+			rebuildOpts = opts
+		}
+
 		log.Printf("[DEBUG] Rebuild configuration: %#v", rebuildOpts)
 
 		_, err = servers.Rebuild(ctx, computeClient, d.Id(), rebuildOpts).Extract()
@@ -1734,6 +1814,23 @@ func resourceInstancePersonalityV2(d *schema.ResourceData) servers.Personality {
 // suppressAvailabilityZoneDetailDiffs will suppress diffs when a user specifies an
 // availability zone in the format of `az:host:node` and Nova/Compute responds with
 // only `az`.
+// computeV2InstanceUnshelveAvailabilityZone returns the availability zone to
+// unshelve the instance into: unshelve_availability_zone if set, otherwise
+// the explicitly configured availability_zone, so that an instance pinned to
+// an availability zone stays there.
+func computeV2InstanceUnshelveAvailabilityZone(d *schema.ResourceData) string {
+	if v := d.Get("unshelve_availability_zone").(string); v != "" {
+		return v
+	}
+
+	if d.GetRawConfig().GetAttr("availability_zone").IsNull() {
+		return ""
+	}
+
+	// The availability zone may also contain the host, e.g. "nova:compute1".
+	return strings.Split(d.Get("availability_zone").(string), ":")[0]
+}
+
 func suppressAvailabilityZoneDetailDiffs(_, o, n string, _ *schema.ResourceData) bool {
 	if strings.Contains(n, ":") {
 		parts := strings.Split(n, ":")
@@ -1749,10 +1846,15 @@ func suppressAvailabilityZoneDetailDiffs(_, o, n string, _ *schema.ResourceData)
 
 // suppressPowerStateDiffs will allow a state of "error" or "migrating" even though we don't
 // allow them as a user input.
-func suppressPowerStateDiffs(_, old, _ string, _ *schema.ResourceData) bool {
+func suppressPowerStateDiffs(_, old, n string, _ *schema.ResourceData) bool {
 	if old == "error" || old == "migrating" {
 		return true
 	}
 
+	// Nova may offload a shelved instance right away.
+	if old == "shelved_offloaded" && strings.ToLower(n) == "shelved" {
+		return true
+	}
+
 	return false
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
@@ -171,6 +172,129 @@ func TestAccComputeV2Instance_initialShelve(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_shelved(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceStateShelved(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					// Nova may offload a shelved instance right away.
+					resource.TestMatchResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", regexp.MustCompile(`^shelved(_offloaded)?$`)),
+					testAccCheckComputeV2InstanceShelved(&instance),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceStateShelve(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shelved_offloaded"),
+					testAccCheckComputeV2InstanceState(&instance, "shelved_offloaded"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceStateShutoff(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shutoff"),
+					testAccCheckComputeV2InstanceState(&instance, "shutoff"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceStateActive(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rescue(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceStateActive(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceStateRescue(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "rescue"),
+					testAccCheckComputeV2InstanceState(&instance, "rescue"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceStateActive(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+					testAccCheckComputeV2InstanceState(&instance, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rebuildOnChange(t *testing.T) {
+	var instance1, instance2 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2InstanceDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceRebuildOnChange("#cloud-config"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance1),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceRebuildOnChange("#cloud-config\nhostname: instance-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(t.Context(), "openstack_compute_instance_v2.instance_1", &instance2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance1, &instance2),
+					testAccCheckComputeV2InstanceRebuilt(&instance1, &instance2),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_initialPaused(t *testing.T) {
 	var instance servers.Server
 
@@ -971,6 +1095,18 @@ func testAccCheckComputeV2InstanceBootVolumeAttachment(ctx context.Context, inst
 	}
 }
 
+func testAccCheckComputeV2InstanceInstanceIDsMatch(
+	instance1, instance2 *servers.Server,
+) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if instance1.ID != instance2.ID {
+			return errors.New("Instance was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceInstanceIDsDoNotMatch(
 	instance1, instance2 *servers.Server,
 ) resource.TestCheckFunc {
@@ -983,6 +1119,18 @@ func testAccCheckComputeV2InstanceInstanceIDsDoNotMatch(
 	}
 }
 
+func testAccCheckComputeV2InstanceRebuilt(
+	instance1, instance2 *servers.Server,
+) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if !instance2.Updated.After(instance1.Updated) {
+			return errors.New("Instance was not rebuilt")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceState(
 	instance *servers.Server, state string,
 ) resource.TestCheckFunc {
@@ -995,6 +1143,17 @@ func testAccCheckComputeV2InstanceState(
 	}
 }
 
+func testAccCheckComputeV2InstanceShelved(instance *servers.Server) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		switch strings.ToLower(instance.Status) {
+		case "shelved", "shelved_offloaded":
+			return nil
+		}
+
+		return fmt.Errorf("Instance state is %s, expected shelved or shelved_offloaded", instance.Status)
+	}
+}
+
 func testAccCheckComputeV2InstanceTags(name string, tags []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1725,6 +1884,51 @@ resource "openstack_compute_instance_v2" "instance_1" {
 `, osNetworkID)
 }
 
+func testAccComputeV2InstanceStateShelved() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "shelved"
+  network {
+    uuid = "%s"
+  }
+}
+`, osNetworkID)
+}
+
+func testAccComputeV2InstanceStateRescue() string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "rescue"
+  network {
+    uuid = "%s"
+  }
+}
+`, osNetworkID)
+}
+
+func testAccComputeV2InstanceRebuildOnChange(userData string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  user_data = <<EOF
+%s
+EOF
+  rebuild_on_change = true
+  metadata = {
+    foo = "bar"
+  }
+  network {
+    uuid = "%s"
+  }
+}
+`, userData, osNetworkID)
+}
+
 func testAccComputeV2InstanceStatePaused() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_instance_v2" "instance_1" {
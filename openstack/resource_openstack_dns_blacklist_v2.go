@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSBlacklistV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSBlacklistV2Create,
+		ReadContext:   resourceDNSBlacklistV2Read,
+		UpdateContext: resourceDNSBlacklistV2Update,
+		DeleteContext: resourceDNSBlacklistV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceDNSBlacklistV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := dnsBlacklistV2Opts{
+		Pattern: d.Get("pattern").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		createOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] openstack_dns_blacklist_v2 create options: %#v", createOpts)
+
+	blacklist, err := dnsBlacklistV2Create(ctx, dnsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_blacklist_v2: %s", err)
+	}
+
+	d.SetId(blacklist.ID)
+
+	return resourceDNSBlacklistV2Read(ctx, d, meta)
+}
+
+func resourceDNSBlacklistV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	blacklist, err := dnsBlacklistV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_blacklist_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_blacklist_v2 %s: %#v", d.Id(), blacklist)
+
+	d.Set("pattern", blacklist.Pattern)
+	d.Set("description", blacklist.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSBlacklistV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts dnsBlacklistV2Opts
+	)
+
+	if d.HasChange("pattern") {
+		hasChange = true
+		updateOpts.Pattern = d.Get("pattern").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_dns_blacklist_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err := dnsBlacklistV2Update(ctx, dnsClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_blacklist_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSBlacklistV2Read(ctx, d, meta)
+}
+
+func resourceDNSBlacklistV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsBlacklistV2Delete(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_blacklist_v2"))
+	}
+
+	return nil
+}
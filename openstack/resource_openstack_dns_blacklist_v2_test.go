@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2Blacklist_basic(t *testing.T) {
+	var blacklist dnsBlacklistV2
+
+	pattern := testAccDNSV2BlacklistPattern()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2BlacklistDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2BlacklistBasic(pattern),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2BlacklistExists(t.Context(), "openstack_dns_blacklist_v2.blacklist_1", &blacklist),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_blacklist_v2.blacklist_1", "description", "a blacklist"),
+				),
+			},
+			{
+				Config: testAccDNSV2BlacklistUpdate(pattern),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2BlacklistExists(t.Context(), "openstack_dns_blacklist_v2.blacklist_1", &blacklist),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_blacklist_v2.blacklist_1", "description", "an updated blacklist"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2BlacklistDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_blacklist_v2" {
+				continue
+			}
+
+			_, err := dnsBlacklistV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Blacklist still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2BlacklistExists(ctx context.Context, n string, blacklist *dnsBlacklistV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		found, err := dnsBlacklistV2Get(ctx, dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Blacklist not found")
+		}
+
+		*blacklist = *found
+
+		return nil
+	}
+}
+
+// testAccDNSV2BlacklistPattern returns a pattern blacklisting a random
+// domain and all of its subdomains.
+func testAccDNSV2BlacklistPattern() string {
+	return fmt.Sprintf(`^([A-Za-z0-9_\\-]+\\.)*accpttest%s\\.com\\.$`, strings.ToLower(acctest.RandString(5)))
+}
+
+func testAccDNSV2BlacklistBasic(pattern string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_blacklist_v2" "blacklist_1" {
+  pattern     = "%s"
+  description = "a blacklist"
+}
+`, pattern)
+}
+
+func testAccDNSV2BlacklistUpdate(pattern string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_blacklist_v2" "blacklist_1" {
+  pattern     = "%s"
+  description = "an updated blacklist"
+}
+`, pattern)
+}
@@ -0,0 +1,242 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSFloatingIPPTRV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSFloatingIPPTRV2Create,
+		ReadContext:   resourceDNSFloatingIPPTRV2Read,
+		UpdateContext: resourceDNSFloatingIPPTRV2Update,
+		DeleteContext: resourceDNSFloatingIPPTRV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ptrdname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSFloatingIPPTRV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	id := dnsFloatingIPPTRV2ID(region, d.Get("floatingip_id").(string))
+
+	setOpts := dnsFloatingIPPTRV2SetOpts{
+		PTRDName: d.Get("ptrdname").(string),
+		TTL:      d.Get("ttl").(int),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		setOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 create options: %#v", setOpts)
+
+	_, err = dnsFloatingIPPTRV2Set(ctx, dnsClient, id, setOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_floatingip_ptr_v2: %s", err)
+	}
+
+	d.SetId(id)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsFloatingIPPTRV2RefreshFunc(ctx, dnsClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_floatingip_ptr_v2 %s to become active: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_dns_floatingip_ptr_v2 %s", id)
+
+	return resourceDNSFloatingIPPTRV2Read(ctx, d, meta)
+}
+
+func resourceDNSFloatingIPPTRV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	region, floatingIPID, err := dnsFloatingIPPTRV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	ptr, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_floatingip_ptr_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_floatingip_ptr_v2 %s: %#v", d.Id(), ptr)
+
+	// Designate keeps returning the floating IP after its PTR record has
+	// been unset, only without a ptrdname.
+	if ptr.PTRDName == "" {
+		log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 %s has no ptrdname, removing from state", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("ptrdname", ptr.PTRDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceDNSFloatingIPPTRV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	region, _, err := dnsFloatingIPPTRV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// Designate requires the ptrdname to be always set.
+	updateOpts := dnsFloatingIPPTRV2SetOpts{
+		PTRDName: d.Get("ptrdname").(string),
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("ttl") {
+		updateOpts.TTL = d.Get("ttl").(int)
+	}
+
+	log.Printf("[DEBUG] openstack_dns_floatingip_ptr_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = dnsFloatingIPPTRV2Set(ctx, dnsClient, d.Id(), updateOpts)
+	if err != nil {
+		return diag.Errorf("Error updating openstack_dns_floatingip_ptr_v2 %s: %s", d.Id(), err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsFloatingIPPTRV2RefreshFunc(ctx, dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_floatingip_ptr_v2 %s to become active: %s", d.Id(), err)
+	}
+
+	return resourceDNSFloatingIPPTRV2Read(ctx, d, meta)
+}
+
+func resourceDNSFloatingIPPTRV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	region, _, err := dnsFloatingIPPTRV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsClient, err := config.DNSV2Client(ctx, region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsFloatingIPPTRV2Unset(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_floatingip_ptr_v2"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Refresh:    dnsFloatingIPPTRV2RefreshFunc(ctx, dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_floatingip_ptr_v2 %s to become deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
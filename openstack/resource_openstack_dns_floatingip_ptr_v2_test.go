@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2FloatingIPPTR_basic(t *testing.T) {
+	var ptr dnsFloatingIPPTRV2
+
+	ptrdname := fmt.Sprintf("mail.accpttest%s.com.", strings.ToLower(acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2FloatingIPPTRDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2FloatingIPPTRBasic(ptrdname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2FloatingIPPTRExists(t.Context(), "openstack_dns_floatingip_ptr_v2.ptr_1", &ptr),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ptrdname", ptrdname),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "description", "a ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSV2FloatingIPPTRUpdate(ptrdname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2FloatingIPPTRExists(t.Context(), "openstack_dns_floatingip_ptr_v2.ptr_1", &ptr),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "description", "an updated ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_floatingip_ptr_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2FloatingIPPTRDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_floatingip_ptr_v2" {
+				continue
+			}
+
+			ptr, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil && ptr.PTRDName != "" {
+				return errors.New("Floating IP PTR record still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2FloatingIPPTRExists(ctx context.Context, n string, ptr *dnsFloatingIPPTRV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		found, err := dnsFloatingIPPTRV2Get(ctx, dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Floating IP PTR record not found")
+		}
+
+		*ptr = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2FloatingIPPTRBasic(ptrdname string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_dns_floatingip_ptr_v2" "ptr_1" {
+  floatingip_id = openstack_networking_floatingip_v2.fip_1.id
+  ptrdname      = "%s"
+  description   = "a ptr record"
+  ttl           = 3000
+}
+`, osPoolName, ptrdname)
+}
+
+func testAccDNSV2FloatingIPPTRUpdate(ptrdname string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_dns_floatingip_ptr_v2" "ptr_1" {
+  floatingip_id = openstack_networking_floatingip_v2.fip_1.id
+  ptrdname      = "%s"
+  description   = "an updated ptr record"
+  ttl           = 6000
+}
+`, osPoolName, ptrdname)
+}
@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2TLD_basic(t *testing.T) {
+	var tld dnsTLDV2
+
+	tldName := "accpttest" + strings.ToLower(acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TLDDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TLDBasic(tldName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TLDExists(t.Context(), "openstack_dns_tld_v2.tld_1", &tld),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tld_v2.tld_1", "name", tldName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tld_v2.tld_1", "description", "a tld"),
+				),
+			},
+			{
+				Config: testAccDNSV2TLDUpdate(tldName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TLDExists(t.Context(), "openstack_dns_tld_v2.tld_1", &tld),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tld_v2.tld_1", "description", "an updated tld"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TLDDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_tld_v2" {
+				continue
+			}
+
+			_, err := dnsTLDV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("TLD still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2TLDExists(ctx context.Context, n string, tld *dnsTLDV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		found, err := dnsTLDV2Get(ctx, dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("TLD not found")
+		}
+
+		*tld = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2TLDBasic(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_tld_v2" "tld_1" {
+  name        = "%s"
+  description = "a tld"
+}
+`, name)
+}
+
+func testAccDNSV2TLDUpdate(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_tld_v2" "tld_1" {
+  name        = "%s"
+  description = "an updated tld"
+}
+`, name)
+}
@@ -0,0 +1,173 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSTSIGKeyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSTSIGKeyV2Create,
+		ReadContext:   resourceDNSTSIGKeyV2Read,
+		UpdateContext: resourceDNSTSIGKeyV2Update,
+		DeleteContext: resourceDNSTSIGKeyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"hmac-md5", "hmac-sha1", "hmac-sha224", "hmac-sha256", "hmac-sha384", "hmac-sha512",
+				}, false),
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ZONE", "POOL",
+				}, false),
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTSIGKeyV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := dnsTSIGKeyV2CreateOpts{
+		Name:       d.Get("name").(string),
+		Algorithm:  d.Get("algorithm").(string),
+		Scope:      d.Get("scope").(string),
+		ResourceID: d.Get("resource_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_tsigkey_v2 create options: %#v", createOpts)
+
+	createOpts.Secret = d.Get("secret").(string)
+
+	tsigkey, err := dnsTSIGKeyV2Create(ctx, dnsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_tsigkey_v2: %s", err)
+	}
+
+	d.SetId(tsigkey.ID)
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	tsigkey, err := dnsTSIGKeyV2Get(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_tsigkey_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_tsigkey_v2 %s: %s", d.Id(), tsigkey.Name)
+
+	d.Set("name", tsigkey.Name)
+	d.Set("algorithm", tsigkey.Algorithm)
+	d.Set("secret", tsigkey.Secret)
+	d.Set("scope", tsigkey.Scope)
+	d.Set("resource_id", tsigkey.ResourceID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSTSIGKeyV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts dnsTSIGKeyV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("algorithm") {
+		hasChange = true
+		updateOpts.Algorithm = d.Get("algorithm").(string)
+	}
+
+	if d.HasChange("secret") {
+		hasChange = true
+		updateOpts.Secret = d.Get("secret").(string)
+	}
+
+	if hasChange {
+		err := dnsTSIGKeyV2Update(ctx, dnsClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_dns_tsigkey_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSTSIGKeyV2Read(ctx, d, meta)
+}
+
+func resourceDNSTSIGKeyV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	dnsClient, err := config.DNSV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsTSIGKeyV2Delete(ctx, dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_tsigkey_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDNSV2TSIGKey_basic(t *testing.T) {
+	var tsigkey dnsTSIGKeyV2
+
+	tsigkeyName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2TSIGKeyDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TSIGKeyBasic(tsigkeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TSIGKeyExists(t.Context(), "openstack_dns_tsigkey_v2.tsigkey_1", &tsigkey),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "name", tsigkeyName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "scope", "POOL"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "resource_id",
+						"data.openstack_dns_pool_v2.pool_1", "id"),
+				),
+			},
+			{
+				Config: testAccDNSV2TSIGKeyUpdate(tsigkeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TSIGKeyExists(t.Context(), "openstack_dns_tsigkey_v2.tsigkey_1", &tsigkey),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "name", tsigkeyName+"-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.tsigkey_1", "secret", "U2VjcmV0VXBkYXRlZA=="),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TSIGKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_dns_tsigkey_v2" {
+				continue
+			}
+
+			_, err := dnsTSIGKeyV2Get(ctx, dnsClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("TSIG key still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSV2TSIGKeyExists(ctx context.Context, n string, tsigkey *dnsTSIGKeyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		dnsClient, err := config.DNSV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %w", err)
+		}
+
+		found, err := dnsTSIGKeyV2Get(ctx, dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("TSIG key not found")
+		}
+
+		*tsigkey = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2TSIGKeyBasic(name string) string {
+	return fmt.Sprintf(`
+data "openstack_dns_pool_v2" "pool_1" {
+  name = "default"
+}
+
+resource "openstack_dns_tsigkey_v2" "tsigkey_1" {
+  name        = "%s"
+  algorithm   = "hmac-sha256"
+  secret      = "U2VjcmV0"
+  scope       = "POOL"
+  resource_id = data.openstack_dns_pool_v2.pool_1.id
+}
+`, name)
+}
+
+func testAccDNSV2TSIGKeyUpdate(name string) string {
+	return fmt.Sprintf(`
+data "openstack_dns_pool_v2" "pool_1" {
+  name = "default"
+}
+
+resource "openstack_dns_tsigkey_v2" "tsigkey_1" {
+  name        = "%s-updated"
+  algorithm   = "hmac-sha512"
+  secret      = "U2VjcmV0VXBkYXRlZA=="
+  scope       = "POOL"
+  resource_id = data.openstack_dns_pool_v2.pool_1.id
+}
+`, name)
+}
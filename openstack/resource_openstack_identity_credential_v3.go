@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/credentials"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityCredentialV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityCredentialV3Create,
+		ReadContext:   resourceIdentityCredentialV3Read,
+		UpdateContext: resourceIdentityCredentialV3Update,
+		DeleteContext: resourceIdentityCredentialV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"blob": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityCredentialV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID := d.Get("user_id").(string)
+	if userID == "" {
+		tokenInfo, err := getTokenInfo(ctx, identityClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		userID = tokenInfo.userID
+	}
+
+	createOpts := credentials.CreateOpts{
+		UserID:    userID,
+		ProjectID: d.Get("project_id").(string),
+		Type:      d.Get("type").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_credential_v3 create options: %#v", createOpts)
+
+	createOpts.Blob = d.Get("blob").(string)
+
+	credential, err := credentials.Create(ctx, identityClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_credential_v3: %s", err)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceIdentityCredentialV3Read(ctx, d, meta)
+}
+
+func resourceIdentityCredentialV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	credential, err := credentials.Get(ctx, identityClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_credential_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_credential_v3 %s of type %s", d.Id(), credential.Type)
+
+	d.Set("user_id", credential.UserID)
+	d.Set("project_id", credential.ProjectID)
+	d.Set("type", credential.Type)
+	d.Set("blob", credential.Blob)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityCredentialV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+
+	var updateOpts credentials.UpdateOpts
+
+	if d.HasChange("user_id") {
+		hasChange = true
+		updateOpts.UserID = d.Get("user_id").(string)
+	}
+
+	if d.HasChange("project_id") {
+		hasChange = true
+		updateOpts.ProjectID = d.Get("project_id").(string)
+	}
+
+	if d.HasChange("type") {
+		hasChange = true
+		updateOpts.Type = d.Get("type").(string)
+	}
+
+	if d.HasChange("blob") {
+		hasChange = true
+		updateOpts.Blob = d.Get("blob").(string)
+	}
+
+	if hasChange {
+		_, err := credentials.Update(ctx, identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_credential_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityCredentialV3Read(ctx, d, meta)
+}
+
+func resourceIdentityCredentialV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = credentials.Delete(ctx, identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_credential_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/credentials"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Credential_basic(t *testing.T) {
+	var credential credentials.Credential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3CredentialDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3CredentialBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3CredentialExists(t.Context(), "openstack_identity_credential_v3.credential_1", &credential),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_credential_v3.credential_1", "type", "totp"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_credential_v3.credential_1", "blob", "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_credential_v3.credential_1", "user_id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3CredentialUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3CredentialExists(t.Context(), "openstack_identity_credential_v3.credential_1", &credential),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_credential_v3.credential_1", "blob", "MFRGGZDFMZTWQ2LKNNWG23TPOBYXE43U"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3CredentialDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_credential_v3" {
+				continue
+			}
+
+			_, err := credentials.Get(ctx, identityClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Credential still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3CredentialExists(ctx context.Context, n string, credential *credentials.Credential) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := credentials.Get(ctx, identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Credential not found")
+		}
+
+		*credential = *found
+
+		return nil
+	}
+}
+
+const testAccIdentityV3CredentialBasic = `
+resource "openstack_identity_credential_v3" "credential_1" {
+  type = "totp"
+  blob = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+}
+`
+
+const testAccIdentityV3CredentialUpdate = `
+resource "openstack_identity_credential_v3" "credential_1" {
+  type = "totp"
+  blob = "MFRGGZDFMZTWQ2LKNNWG23TPOBYXE43U"
+}
+`
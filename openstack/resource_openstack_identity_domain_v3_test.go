@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3Domain_basic(t *testing.T) {
+	var domain domains.Domain
+
+	domainName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3DomainDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3DomainBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists(t.Context(), "openstack_identity_domain_v3.domain_1", &domain),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "name", &domain.Name),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "description", &domain.Description),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_v3.domain_1", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccIdentityV3DomainUpdate(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists(t.Context(), "openstack_identity_domain_v3.domain_1", &domain),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "name", &domain.Name),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "description", &domain.Description),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_v3.domain_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3DomainDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_domain_v3" {
+				continue
+			}
+
+			_, err := domains.Get(ctx, identityClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return errors.New("Domain still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3DomainExists(ctx context.Context, n string, domain *domains.Domain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := domains.Get(ctx, identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Domain not found")
+		}
+
+		*domain = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3DomainBasic(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name        = "%s"
+  description = "A domain"
+}
+`, domainName)
+}
+
+func testAccIdentityV3DomainUpdate(domainName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name        = "%s"
+  description = "Some domain"
+  enabled     = false
+}
+`, domainName)
+}
@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceIdentityFederationMappingV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityFederationMappingV3Create,
+		ReadContext:   resourceIdentityFederationMappingV3Read,
+		UpdateContext: resourceIdentityFederationMappingV3Update,
+		DeleteContext: resourceIdentityFederationMappingV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// The rules are passed through to Keystone as is. The user
+			// can use jsonencode to pass them properly.
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJSONArray,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityFederationMappingV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	rules := json.RawMessage(d.Get("rules").(string))
+
+	log.Printf("[DEBUG] openstack_identity_federation_mapping_v3 %s rules: %s", name, rules)
+
+	mapping, err := identityFederationMappingV3Create(ctx, identityClient, name, rules)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_federation_mapping_v3 %s: %s", name, err)
+	}
+
+	d.SetId(mapping.ID)
+
+	return resourceIdentityFederationMappingV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationMappingV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	mapping, err := identityFederationMappingV3Get(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_federation_mapping_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_federation_mapping_v3: %#v", mapping)
+
+	rules, err := structure.NormalizeJsonString(string(mapping.Rules))
+	if err != nil {
+		return diag.Errorf("Error normalizing rules of openstack_identity_federation_mapping_v3 %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", mapping.ID)
+	d.Set("rules", rules)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityFederationMappingV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	if d.HasChange("rules") {
+		rules := json.RawMessage(d.Get("rules").(string))
+
+		log.Printf("[DEBUG] openstack_identity_federation_mapping_v3 %s rules: %s", d.Id(), rules)
+
+		err := identityFederationMappingV3Update(ctx, identityClient, d.Id(), rules)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_federation_mapping_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityFederationMappingV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationMappingV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityFederationMappingV3Delete(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_federation_mapping_v3"))
+	}
+
+	return nil
+}
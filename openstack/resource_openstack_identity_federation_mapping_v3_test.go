@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3FederationMapping_basic(t *testing.T) {
+	var mapping identityFederationMappingV3
+
+	mappingName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3FederationMappingDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3FederationMappingBasic(mappingName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationMappingExists(t.Context(), "openstack_identity_federation_mapping_v3.mapping_1", &mapping),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_mapping_v3.mapping_1", "name", mappingName),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_mapping_v3.mapping_1", "rules",
+						`[{"local":[{"user":{"name":"{0}"}}],"remote":[{"type":"REMOTE_USER"}]}]`),
+				),
+			},
+			{
+				Config: testAccIdentityV3FederationMappingUpdate(mappingName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationMappingExists(t.Context(), "openstack_identity_federation_mapping_v3.mapping_1", &mapping),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_mapping_v3.mapping_1", "rules",
+						`[{"local":[{"user":{"name":"{0}"}},{"group":{"id":"0cd5e9"}}],"remote":[{"type":"REMOTE_USER"},{"any_one_of":["admins"],"type":"REMOTE_GROUPS"}]}]`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3FederationMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_federation_mapping_v3" {
+				continue
+			}
+
+			_, err := identityFederationMappingV3Get(ctx, identityClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Mapping still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3FederationMappingExists(ctx context.Context, n string, mapping *identityFederationMappingV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := identityFederationMappingV3Get(ctx, identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Mapping not found")
+		}
+
+		*mapping = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3FederationMappingBasic(mappingName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_federation_mapping_v3" "mapping_1" {
+  name  = "%s"
+  rules = jsonencode([
+    {
+      local = [
+        {
+          user = {
+            name = "{0}"
+          }
+        }
+      ]
+      remote = [
+        {
+          type = "REMOTE_USER"
+        }
+      ]
+    }
+  ])
+}
+`, mappingName)
+}
+
+func testAccIdentityV3FederationMappingUpdate(mappingName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_federation_mapping_v3" "mapping_1" {
+  name  = "%s"
+  rules = jsonencode([
+    {
+      local = [
+        {
+          user = {
+            name = "{0}"
+          }
+        },
+        {
+          group = {
+            id = "0cd5e9"
+          }
+        }
+      ]
+      remote = [
+        {
+          type = "REMOTE_USER"
+        },
+        {
+          type       = "REMOTE_GROUPS"
+          any_one_of = ["admins"]
+        }
+      ]
+    }
+  ])
+}
+`, mappingName)
+}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityFederationProtocolV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityFederationProtocolV3Create,
+		ReadContext:   resourceIdentityFederationProtocolV3Read,
+		UpdateContext: resourceIdentityFederationProtocolV3Update,
+		DeleteContext: resourceIdentityFederationProtocolV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"identity_provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"mapping_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remote_id_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityFederationProtocolV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID := d.Get("identity_provider_id").(string)
+	name := d.Get("name").(string)
+	createOpts := identityFederationProtocolV3Opts{
+		MappingID:         d.Get("mapping_id").(string),
+		RemoteIDAttribute: d.Get("remote_id_attribute").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_federation_protocol_v3 create options: %#v", createOpts)
+
+	protocol, err := identityFederationProtocolV3Create(ctx, identityClient, idpID, name, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_federation_protocol_v3 %s: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", idpID, protocol.ID))
+
+	return resourceIdentityFederationProtocolV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationProtocolV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_federation_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	protocol, err := identityFederationProtocolV3Get(ctx, identityClient, idpID, name)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_federation_protocol_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_federation_protocol_v3: %#v", protocol)
+
+	d.Set("identity_provider_id", idpID)
+	d.Set("name", protocol.ID)
+	d.Set("mapping_id", protocol.MappingID)
+	d.Set("remote_id_attribute", protocol.RemoteIDAttribute)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityFederationProtocolV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_federation_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("mapping_id", "remote_id_attribute") {
+		updateOpts := identityFederationProtocolV3Opts{
+			MappingID:         d.Get("mapping_id").(string),
+			RemoteIDAttribute: d.Get("remote_id_attribute").(string),
+		}
+
+		log.Printf("[DEBUG] openstack_identity_federation_protocol_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		err := identityFederationProtocolV3Update(ctx, identityClient, idpID, name, updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_federation_protocol_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityFederationProtocolV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationProtocolV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	idpID, name, err := parsePairedIDs(d.Id(), "openstack_identity_federation_protocol_v3")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = identityFederationProtocolV3Delete(ctx, identityClient, idpID, name)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_federation_protocol_v3"))
+	}
+
+	return nil
+}
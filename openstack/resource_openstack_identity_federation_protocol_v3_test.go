@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3FederationProtocol_basic(t *testing.T) {
+	var protocol identityFederationProtocolV3
+
+	name := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3FederationProtocolDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3FederationProtocolBasic(name, "mapping_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationProtocolExists(t.Context(), "openstack_identity_federation_protocol_v3.protocol_1", &protocol),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_protocol_v3.protocol_1", "name", "saml2"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_federation_protocol_v3.protocol_1", "identity_provider_id",
+						"openstack_identity_federation_provider_v3.provider_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_federation_protocol_v3.protocol_1", "mapping_id",
+						"openstack_identity_federation_mapping_v3.mapping_1", "id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3FederationProtocolBasic(name, "mapping_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationProtocolExists(t.Context(), "openstack_identity_federation_protocol_v3.protocol_1", &protocol),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_federation_protocol_v3.protocol_1", "mapping_id",
+						"openstack_identity_federation_mapping_v3.mapping_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3FederationProtocolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_federation_protocol_v3" {
+				continue
+			}
+
+			idpID, name, err := parsePairedIDs(rs.Primary.ID, "openstack_identity_federation_protocol_v3")
+			if err != nil {
+				return err
+			}
+
+			_, err = identityFederationProtocolV3Get(ctx, identityClient, idpID, name)
+			if err == nil {
+				return errors.New("Protocol still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3FederationProtocolExists(ctx context.Context, n string, protocol *identityFederationProtocolV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		idpID, name, err := parsePairedIDs(rs.Primary.ID, "openstack_identity_federation_protocol_v3")
+		if err != nil {
+			return err
+		}
+
+		found, err := identityFederationProtocolV3Get(ctx, identityClient, idpID, name)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != name {
+			return errors.New("Protocol not found")
+		}
+
+		*protocol = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3FederationProtocolBasic(name, mapping string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_federation_provider_v3" "provider_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_federation_mapping_v3" "mapping_1" {
+  name  = "%[1]s-1"
+  rules = jsonencode([
+    {
+      local  = [{ user = { name = "{0}" } }]
+      remote = [{ type = "REMOTE_USER" }]
+    }
+  ])
+}
+
+resource "openstack_identity_federation_mapping_v3" "mapping_2" {
+  name  = "%[1]s-2"
+  rules = jsonencode([
+    {
+      local  = [{ user = { name = "{0}" } }]
+      remote = [{ type = "OIDC-preferred_username" }]
+    }
+  ])
+}
+
+resource "openstack_identity_federation_protocol_v3" "protocol_1" {
+  identity_provider_id = openstack_identity_federation_provider_v3.provider_1.id
+  name                 = "saml2"
+  mapping_id           = openstack_identity_federation_mapping_v3.%[2]s.id
+}
+`, name, mapping)
+}
@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdentityFederationProviderV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityFederationProviderV3Create,
+		ReadContext:   resourceIdentityFederationProviderV3Read,
+		UpdateContext: resourceIdentityFederationProviderV3Update,
+		DeleteContext: resourceIdentityFederationProviderV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"remote_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIdentityFederationProviderV3Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	createOpts := identityFederationProviderV3CreateOpts{
+		DomainID:    d.Get("domain_id").(string),
+		Description: d.Get("description").(string),
+		Enabled:     &enabled,
+		RemoteIDs:   expandToStringSlice(d.Get("remote_ids").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_federation_provider_v3 create options: %#v", createOpts)
+
+	provider, err := identityFederationProviderV3Create(ctx, identityClient, name, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_identity_federation_provider_v3 %s: %s", name, err)
+	}
+
+	d.SetId(provider.ID)
+
+	return resourceIdentityFederationProviderV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationProviderV3Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	provider, err := identityFederationProviderV3Get(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_identity_federation_provider_v3"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_federation_provider_v3: %#v", provider)
+
+	d.Set("name", provider.ID)
+	d.Set("domain_id", provider.DomainID)
+	d.Set("description", provider.Description)
+	d.Set("enabled", provider.Enabled)
+	d.Set("remote_ids", provider.RemoteIDs)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityFederationProviderV3Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+
+	var updateOpts identityFederationProviderV3UpdateOpts
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("remote_ids") {
+		hasChange = true
+		remoteIDs := expandToStringSlice(d.Get("remote_ids").(*schema.Set).List())
+		updateOpts.RemoteIDs = &remoteIDs
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_identity_federation_provider_v3 %s update options: %#v", d.Id(), updateOpts)
+
+		err := identityFederationProviderV3Update(ctx, identityClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_identity_federation_provider_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityFederationProviderV3Read(ctx, d, meta)
+}
+
+func resourceIdentityFederationProviderV3Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	identityClient, err := config.IdentityV3Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityFederationProviderV3Delete(ctx, identityClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_identity_federation_provider_v3"))
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIdentityV3FederationProvider_basic(t *testing.T) {
+	var provider identityFederationProviderV3
+
+	idpName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckIdentityV3FederationProviderDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3FederationProviderBasic(idpName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationProviderExists(t.Context(), "openstack_identity_federation_provider_v3.provider_1", &provider),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "name", idpName),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "description", "A SAML identity provider"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "remote_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_federation_provider_v3.provider_1", "domain_id",
+						"openstack_identity_domain_v3.domain_1", "id"),
+				),
+			},
+			{
+				Config: testAccIdentityV3FederationProviderUpdate(idpName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3FederationProviderExists(t.Context(), "openstack_identity_federation_provider_v3.provider_1", &provider),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "description", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_federation_provider_v3.provider_1", "remote_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3FederationProviderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_identity_federation_provider_v3" {
+				continue
+			}
+
+			_, err := identityFederationProviderV3Get(ctx, identityClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Identity provider still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityV3FederationProviderExists(ctx context.Context, n string, provider *identityFederationProviderV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		identityClient, err := config.IdentityV3Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %w", err)
+		}
+
+		found, err := identityFederationProviderV3Get(ctx, identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Identity provider not found")
+		}
+
+		*provider = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3FederationProviderBasic(idpName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_federation_provider_v3" "provider_1" {
+  name        = "%[1]s"
+  description = "A SAML identity provider"
+  domain_id   = openstack_identity_domain_v3.domain_1.id
+  remote_ids  = ["https://%[1]s.example.com/saml2/idp"]
+}
+`, idpName)
+}
+
+func testAccIdentityV3FederationProviderUpdate(idpName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_domain_v3" "domain_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_identity_federation_provider_v3" "provider_1" {
+  name       = "%[1]s"
+  enabled    = false
+  domain_id  = openstack_identity_domain_v3.domain_1.id
+  remote_ids = [
+    "https://%[1]s.example.com/saml2/idp",
+    "https://%[1]s.example.org/saml2/idp",
+  ]
+}
+`, idpName)
+}
@@ -137,6 +137,13 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			// failover_trigger is an arbitrary value which triggers a
+			// failover of the load balancer whenever it changes.
+			"failover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -348,6 +355,36 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("failover_trigger") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		// Wait for load-balancer to become active before continuing.
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Triggering failover of openstack_lb_loadbalancer_v2 %s", d.Id())
+
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err = loadbalancers.Failover(ctx, lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return diag.Errorf("Error triggering failover of openstack_lb_loadbalancer_v2 %s: %s", d.Id(), err)
+		}
+
+		// Wait for load-balancer to become active after the failover.
+		err = waitForLBV2LoadBalancer(ctx, lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
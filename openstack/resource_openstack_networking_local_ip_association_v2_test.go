@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2LocalIPAssociation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPAssociationDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPAssociationBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_association_v2.association_1", "fixed_ip", "192.168.199.20"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_association_v2.association_1", "local_ip_address",
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LocalIPAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_local_ip_association_v2" {
+				continue
+			}
+
+			localIPID, fixedPortID, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_local_ip_association_v2")
+			if err != nil {
+				return err
+			}
+
+			listOpts := networkingLocalIPAssociationV2ListOpts{
+				FixedPortID: fixedPortID,
+			}
+
+			associations, err := networkingLocalIPAssociationV2List(ctx, networkClient, localIPID, listOpts)
+			if err == nil && len(associations) > 0 {
+				return errors.New("Local IP association still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2LocalIPAssociationBasic = testAccNetworkingV2LocalIPNetwork + `
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name       = "local_ip_1"
+  network_id = openstack_networking_network_v2.network_1.id
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id  = openstack_networking_subnet_v2.subnet_1.id
+    ip_address = "192.168.199.20"
+  }
+}
+
+resource "openstack_networking_local_ip_association_v2" "association_1" {
+  local_ip_id   = openstack_networking_local_ip_v2.local_ip_1.id
+  fixed_port_id = openstack_networking_port_v2.port_1.id
+  fixed_ip      = "192.168.199.20"
+}
+`
@@ -0,0 +1,237 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLocalIPV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLocalIPV2Create,
+		ReadContext:   resourceNetworkingLocalIPV2Read,
+		UpdateContext: resourceNetworkingLocalIPV2Update,
+		DeleteContext: resourceNetworkingLocalIPV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"network_id", "local_port_id"},
+			},
+
+			"local_port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"ip_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"translate", "passthrough",
+				}, false),
+			},
+
+			"value_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"all_tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkingLocalIPV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	createOpts := networkingLocalIPV2CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ProjectID:      d.Get("project_id").(string),
+		NetworkID:      d.Get("network_id").(string),
+		LocalPortID:    d.Get("local_port_id").(string),
+		LocalIPAddress: d.Get("local_ip_address").(string),
+		IPMode:         d.Get("ip_mode").(string),
+		ValueSpecs:     MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_local_ip_v2 create options: %#v", createOpts)
+
+	localIP, err := networkingLocalIPV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_local_ip_v2: %s", err)
+	}
+
+	d.SetId(localIP.ID)
+
+	tags := networkingV2AttributesTags(d)
+	if len(tags) > 0 {
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+
+		tags, err := attributestags.ReplaceAll(ctx, networkingClient, "local_ips", localIP.ID, tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on openstack_networking_local_ip_v2 %s: %s", localIP.ID, err)
+		}
+
+		log.Printf("[DEBUG] Set tags %s on openstack_networking_local_ip_v2 %s", tags, localIP.ID)
+	}
+
+	log.Printf("[DEBUG] Created openstack_networking_local_ip_v2 %s: %#v", localIP.ID, localIP)
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	localIP, err := networkingLocalIPV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_local_ip_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_v2 %s: %#v", d.Id(), localIP)
+
+	d.Set("name", localIP.Name)
+	d.Set("description", localIP.Description)
+	d.Set("project_id", localIP.ProjectID)
+	d.Set("network_id", localIP.NetworkID)
+	d.Set("local_port_id", localIP.LocalPortID)
+	d.Set("local_ip_address", localIP.LocalIPAddress)
+	d.Set("ip_mode", localIP.IPMode)
+	d.Set("region", GetRegion(d, config))
+
+	networkingV2ReadAttributesTags(d, localIP.Tags)
+
+	return nil
+}
+
+func resourceNetworkingLocalIPV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts networkingLocalIPV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_local_ip_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = networkingLocalIPV2Update(ctx, networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_local_ip_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := networkingV2UpdateAttributesTags(d)
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+
+		tags, err := attributestags.ReplaceAll(ctx, networkingClient, "local_ips", d.Id(), tagOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting tags on openstack_networking_local_ip_v2 %s: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Set tags %s on openstack_networking_local_ip_v2 %s", tags, d.Id())
+	}
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	if err := networkingLocalIPV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_local_ip_v2"))
+	}
+
+	return nil
+}
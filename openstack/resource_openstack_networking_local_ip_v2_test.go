@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2LocalIP_basic(t *testing.T) {
+	var localIP networkingLocalIPV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLocalIP(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists(t.Context(), "openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "description", "a local ip"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "ip_mode", "translate"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "all_tags.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_local_ip_v2.local_ip_1", "local_port_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2LocalIPUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists(t.Context(), "openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "description", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "all_tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LocalIPDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_local_ip_v2" {
+				continue
+			}
+
+			_, err := networkingLocalIPV2Get(ctx, networkClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Local IP still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2LocalIPExists(ctx context.Context, n string, localIP *networkingLocalIPV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingLocalIPV2Get(ctx, networkClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Local IP not found")
+		}
+
+		*localIP = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2LocalIPNetwork = `
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_1.id
+}
+`
+
+const testAccNetworkingV2LocalIPBasic = testAccNetworkingV2LocalIPNetwork + `
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name        = "local_ip_1"
+  description = "a local ip"
+  network_id  = openstack_networking_network_v2.network_1.id
+  ip_mode     = "translate"
+  tags        = ["foo"]
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+`
+
+const testAccNetworkingV2LocalIPUpdate = testAccNetworkingV2LocalIPNetwork + `
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name       = "local_ip_1_updated"
+  network_id = openstack_networking_network_v2.network_1.id
+  ip_mode    = "translate"
+  tags       = ["foo", "bar"]
+
+  depends_on = [openstack_networking_subnet_v2.subnet_1]
+}
+`
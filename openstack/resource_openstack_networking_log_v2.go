@@ -0,0 +1,211 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLogV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLogV2Create,
+		ReadContext:   resourceNetworkingLogV2Read,
+		UpdateContext: resourceNetworkingLogV2Update,
+		DeleteContext: resourceNetworkingLogV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"security_group", "firewall_group",
+				}, false),
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"target_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"event": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ALL",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ALL", "ACCEPT", "DROP",
+				}, false),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingLogV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := networkingLogV2CreateOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		ProjectID:    d.Get("project_id").(string),
+		ResourceType: d.Get("resource_type").(string),
+		ResourceID:   d.Get("resource_id").(string),
+		TargetID:     d.Get("target_id").(string),
+		Event:        d.Get("event").(string),
+		Enabled:      &enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_log_v2 create options: %#v", createOpts)
+
+	l, err := networkingLogV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_log_v2: %s", err)
+	}
+
+	d.SetId(l.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_log_v2 %s: %#v", l.ID, l)
+
+	return resourceNetworkingLogV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLogV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l, err := networkingLogV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_log_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_log_v2 %s: %#v", d.Id(), l)
+
+	d.Set("name", l.Name)
+	d.Set("description", l.Description)
+	d.Set("project_id", l.ProjectID)
+	d.Set("resource_type", l.ResourceType)
+	d.Set("resource_id", l.ResourceID)
+	d.Set("target_id", l.TargetID)
+	d.Set("event", l.Event)
+	d.Set("enabled", l.Enabled)
+	d.Set("revision_number", l.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingLogV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts networkingLogV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_log_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = networkingLogV2Update(ctx, networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_log_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingLogV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLogV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingLogV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_log_v2"))
+	}
+
+	return nil
+}
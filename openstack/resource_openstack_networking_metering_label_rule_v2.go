@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingMeteringLabelRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingMeteringLabelRuleV2Create,
+		ReadContext:   resourceNetworkingMeteringLabelRuleV2Read,
+		DeleteContext: resourceNetworkingMeteringLabelRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Metering label rules cannot be updated, so every argument forces
+		// a new rule.
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"metering_label_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ingress",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ingress", "egress",
+				}, false),
+			},
+
+			"remote_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"source_ip_prefix", "destination_ip_prefix"},
+			},
+
+			"source_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"remote_ip_prefix"},
+			},
+
+			"destination_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsCIDR,
+				ConflictsWith: []string{"remote_ip_prefix"},
+			},
+
+			"excluded": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingMeteringLabelRuleV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	excluded := d.Get("excluded").(bool)
+	createOpts := networkingMeteringLabelRuleV2CreateOpts{
+		MeteringLabelID:     d.Get("metering_label_id").(string),
+		Direction:           d.Get("direction").(string),
+		RemoteIPPrefix:      d.Get("remote_ip_prefix").(string),
+		SourceIPPrefix:      d.Get("source_ip_prefix").(string),
+		DestinationIPPrefix: d.Get("destination_ip_prefix").(string),
+		Excluded:            &excluded,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_metering_label_rule_v2 create options: %#v", createOpts)
+
+	rule, err := networkingMeteringLabelRuleV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_metering_label_rule_v2: %s", err)
+	}
+
+	d.SetId(rule.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_metering_label_rule_v2 %s: %#v", rule.ID, rule)
+
+	return resourceNetworkingMeteringLabelRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingMeteringLabelRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := networkingMeteringLabelRuleV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_metering_label_rule_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_metering_label_rule_v2 %s: %#v", d.Id(), rule)
+
+	d.Set("metering_label_id", rule.MeteringLabelID)
+	d.Set("direction", rule.Direction)
+	d.Set("remote_ip_prefix", rule.RemoteIPPrefix)
+	d.Set("source_ip_prefix", rule.SourceIPPrefix)
+	d.Set("destination_ip_prefix", rule.DestinationIPPrefix)
+	d.Set("excluded", rule.Excluded)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingMeteringLabelRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingMeteringLabelRuleV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_metering_label_rule_v2"))
+	}
+
+	return nil
+}
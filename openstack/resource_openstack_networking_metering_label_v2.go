@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetworkingMeteringLabelV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingMeteringLabelV2Create,
+		ReadContext:   resourceNetworkingMeteringLabelV2Read,
+		DeleteContext: resourceNetworkingMeteringLabelV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingMeteringLabelV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	shared := d.Get("shared").(bool)
+	createOpts := networkingMeteringLabelV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProjectID:   d.Get("project_id").(string),
+		Shared:      &shared,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_metering_label_v2 create options: %#v", createOpts)
+
+	label, err := networkingMeteringLabelV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_metering_label_v2: %s", err)
+	}
+
+	d.SetId(label.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_metering_label_v2 %s: %#v", label.ID, label)
+
+	return resourceNetworkingMeteringLabelV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingMeteringLabelV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	label, err := networkingMeteringLabelV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_metering_label_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_metering_label_v2 %s: %#v", d.Id(), label)
+
+	d.Set("name", label.Name)
+	d.Set("description", label.Description)
+	d.Set("project_id", label.ProjectID)
+	d.Set("shared", label.Shared)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingMeteringLabelV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingMeteringLabelV2Delete(ctx, networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_metering_label_v2"))
+	}
+
+	return nil
+}
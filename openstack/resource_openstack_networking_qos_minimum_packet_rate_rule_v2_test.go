@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2QoSMinimumPacketRateRule_basic(t *testing.T) {
+	var (
+		policy policies.Policy
+		rule   networkingQoSMinimumPacketRateRuleV2
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2QoSMinimumPacketRateRuleDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSMinimumPacketRateRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists(t.Context(),
+						"openstack_networking_qos_policy_v2.qos_policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					testAccCheckNetworkingV2QoSMinimumPacketRateRuleExists(t.Context(),
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "min_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "direction", "egress"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2QoSMinimumPacketRateRuleUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists(t.Context(),
+						"openstack_networking_qos_policy_v2.qos_policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					testAccCheckNetworkingV2QoSMinimumPacketRateRuleExists(t.Context(),
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "min_kpps", "2000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "direction", "any"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSMinimumPacketRateRuleExists(ctx context.Context, n string, rule *networkingQoSMinimumPacketRateRuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		qosPolicyID, qosRuleID, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_qos_minimum_packet_rate_rule_v2")
+		if err != nil {
+			return err
+		}
+
+		found, err := networkingQoSMinimumPacketRateRuleV2Get(ctx, networkingClient, qosPolicyID, qosRuleID)
+		if err != nil {
+			return err
+		}
+
+		foundID := resourceNetworkingQoSRuleV2BuildID(qosPolicyID, found.ID)
+
+		if foundID != rs.Primary.ID {
+			return errors.New("QoS minimum packet rate rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2QoSMinimumPacketRateRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_qos_minimum_packet_rate_rule_v2" {
+				continue
+			}
+
+			qosPolicyID, qosRuleID, err := parsePairedIDs(rs.Primary.ID, "openstack_networking_qos_minimum_packet_rate_rule_v2")
+			if err != nil {
+				return err
+			}
+
+			_, err = networkingQoSMinimumPacketRateRuleV2Get(ctx, networkingClient, qosPolicyID, qosRuleID)
+			if err == nil {
+				return errors.New("QoS rule still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSMinimumPacketRateRuleBasic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = openstack_networking_qos_policy_v2.qos_policy_1.id
+  min_kpps      = 3000
+}
+`
+
+const testAccNetworkingV2QoSMinimumPacketRateRuleUpdate = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = openstack_networking_qos_policy_v2.qos_policy_1.id
+  min_kpps      = 2000
+  direction     = "any"
+}
+`
@@ -0,0 +1,220 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingQoSPacketRateLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingQoSPacketRateLimitRuleV2Create,
+		ReadContext:   resourceNetworkingQoSPacketRateLimitRuleV2Read,
+		UpdateContext: resourceNetworkingQoSPacketRateLimitRuleV2Update,
+		DeleteContext: resourceNetworkingQoSPacketRateLimitRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"max_kpps": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: false,
+			},
+
+			"max_burst_kpps": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Default:  "egress",
+				Optional: true,
+				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					"egress", "ingress",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSPacketRateLimitRuleV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingQoSPacketRateLimitRuleV2CreateOpts{
+		MaxKpps:      d.Get("max_kpps").(int),
+		MaxBurstKpps: d.Get("max_burst_kpps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] openstack_networking_qos_packet_rate_limit_rule_v2 create options: %#v", createOpts)
+
+	r, err := networkingQoSPacketRateLimitRuleV2Create(ctx, networkingClient, qosPolicyID, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_qos_packet_rate_limit_rule_v2: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for openstack_networking_qos_packet_rate_limit_rule_v2 %s to become available.", r.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    networkingQoSPacketRateLimitRuleV2StateRefreshFunc(ctx, networkingClient, qosPolicyID, r.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_networking_qos_packet_rate_limit_rule_v2 %s to become available: %s", r.ID, err)
+	}
+
+	id := resourceNetworkingQoSRuleV2BuildID(qosPolicyID, r.ID)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_networking_qos_packet_rate_limit_rule_v2 %s: %#v", id, r)
+
+	return resourceNetworkingQoSPacketRateLimitRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPacketRateLimitRuleV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parsePairedIDs(d.Id(), "openstack_networking_qos_packet_rate_limit_rule_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	r, err := networkingQoSPacketRateLimitRuleV2Get(ctx, networkingClient, qosPolicyID, qosRuleID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_qos_packet_rate_limit_rule_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_qos_packet_rate_limit_rule_v2 %s: %#v", d.Id(), r)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("max_kpps", r.MaxKpps)
+	d.Set("max_burst_kpps", r.MaxBurstKpps)
+	d.Set("direction", r.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSPacketRateLimitRuleV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parsePairedIDs(d.Id(), "openstack_networking_qos_packet_rate_limit_rule_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var hasChange bool
+
+	var updateOpts networkingQoSPacketRateLimitRuleV2UpdateOpts
+
+	if d.HasChange("max_kpps") {
+		hasChange = true
+		maxKpps := d.Get("max_kpps").(int)
+		updateOpts.MaxKpps = &maxKpps
+	}
+
+	if d.HasChange("max_burst_kpps") {
+		hasChange = true
+		maxBurstKpps := d.Get("max_burst_kpps").(int)
+		updateOpts.MaxBurstKpps = &maxBurstKpps
+	}
+
+	if d.HasChange("direction") {
+		hasChange = true
+		updateOpts.Direction = d.Get("direction").(string)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_qos_packet_rate_limit_rule_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = networkingQoSPacketRateLimitRuleV2Update(ctx, networkingClient, qosPolicyID, qosRuleID, updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_qos_packet_rate_limit_rule_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingQoSPacketRateLimitRuleV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingQoSPacketRateLimitRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parsePairedIDs(d.Id(), "openstack_networking_qos_packet_rate_limit_rule_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := networkingQoSPacketRateLimitRuleV2Delete(ctx, networkingClient, qosPolicyID, qosRuleID); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_qos_packet_rate_limit_rule_v2"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    networkingQoSPacketRateLimitRuleV2StateRefreshFunc(ctx, networkingClient, qosPolicyID, qosRuleID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for openstack_networking_qos_packet_rate_limit_rule_v2 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
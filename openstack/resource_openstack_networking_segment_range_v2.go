@@ -0,0 +1,226 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingSegmentRangeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSegmentRangeV2Create,
+		ReadContext:   resourceNetworkingSegmentRangeV2Read,
+		UpdateContext: resourceNetworkingSegmentRangeV2Update,
+		DeleteContext: resourceNetworkingSegmentRangeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vlan",
+					"vxlan",
+					"gre",
+					"geneve",
+				}, false),
+			},
+
+			"physical_network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"minimum": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"maximum": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"available": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"used": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"revision_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSegmentRangeV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := networkingSegmentRangeV2CreateOpts{
+		Name:            d.Get("name").(string),
+		ProjectID:       d.Get("project_id").(string),
+		NetworkType:     d.Get("network_type").(string),
+		PhysicalNetwork: d.Get("physical_network").(string),
+		Minimum:         d.Get("minimum").(int),
+		Maximum:         d.Get("maximum").(int),
+	}
+
+	if v, ok := getOkExists(d, "shared"); ok {
+		shared := v.(bool)
+		createOpts.Shared = &shared
+	}
+
+	log.Printf("[DEBUG] openstack_networking_segment_range_v2 create options: %#v", createOpts)
+
+	segmentRange, err := networkingSegmentRangeV2Create(ctx, networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_segment_range_v2: %s", err)
+	}
+
+	d.SetId(segmentRange.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_segment_range_v2 %s: %#v", segmentRange.ID, segmentRange)
+
+	return resourceNetworkingSegmentRangeV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSegmentRangeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	segmentRange, err := networkingSegmentRangeV2Get(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_segment_range_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_segment_range_v2 %s: %#v", d.Id(), segmentRange)
+
+	d.Set("name", segmentRange.Name)
+	d.Set("network_type", segmentRange.NetworkType)
+	d.Set("physical_network", segmentRange.PhysicalNetwork)
+	d.Set("minimum", segmentRange.Minimum)
+	d.Set("maximum", segmentRange.Maximum)
+	d.Set("shared", segmentRange.Shared)
+	d.Set("project_id", segmentRange.ProjectID)
+	d.Set("default", segmentRange.Default)
+	d.Set("available", segmentRange.Available)
+	d.Set("used", segmentRange.Used)
+	d.Set("revision_number", segmentRange.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSegmentRangeV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var (
+		hasChange  bool
+		updateOpts networkingSegmentRangeV2UpdateOpts
+	)
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("minimum") {
+		hasChange = true
+		minimum := d.Get("minimum").(int)
+		updateOpts.Minimum = &minimum
+	}
+
+	if d.HasChange("maximum") {
+		hasChange = true
+		maximum := d.Get("maximum").(int)
+		updateOpts.Maximum = &maximum
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_segment_range_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = networkingSegmentRangeV2Update(ctx, networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_segment_range_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingSegmentRangeV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSegmentRangeV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	networkingClient, err := config.NetworkingV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingSegmentRangeV2Delete(ctx, networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_segment_range_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkingV2SegmentRange_basic(t *testing.T) {
+	var segmentRange networkingSegmentRangeV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2SegmentRangeDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SegmentRangeBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SegmentRangeExists(t.Context(), "openstack_networking_segment_range_v2.range_1", &segmentRange),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "name", "range_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "network_type", "vxlan"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "minimum", "1000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "maximum", "1009"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "shared", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "default", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SegmentRangeUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SegmentRangeExists(t.Context(), "openstack_networking_segment_range_v2.range_1", &segmentRange),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "name", "range_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "minimum", "1000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_segment_range_v2.range_1", "maximum", "1019"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SegmentRangeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_networking_segment_range_v2" {
+				continue
+			}
+
+			_, err := networkingSegmentRangeV2Get(ctx, networkingClient, rs.Primary.ID)
+			if err == nil {
+				return errors.New("Segment range still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2SegmentRangeExists(ctx context.Context, n string, segmentRange *networkingSegmentRangeV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		networkingClient, err := config.NetworkingV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %w", err)
+		}
+
+		found, err := networkingSegmentRangeV2Get(ctx, networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Segment range not found")
+		}
+
+		*segmentRange = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2SegmentRangeBasic = `
+resource "openstack_networking_segment_range_v2" "range_1" {
+  name         = "range_1"
+  network_type = "vxlan"
+  minimum      = 1000
+  maximum      = 1009
+  shared       = true
+}
+`
+
+const testAccNetworkingV2SegmentRangeUpdate = `
+resource "openstack_networking_segment_range_v2" "range_1" {
+  name         = "range_2"
+  network_type = "vxlan"
+  minimum      = 1000
+  maximum      = 1019
+  shared       = true
+}
+`
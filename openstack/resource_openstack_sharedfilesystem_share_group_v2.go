@@ -0,0 +1,220 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareGroupV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareGroupV2Create,
+		ReadContext:   resourceSharedFilesystemShareGroupV2Read,
+		UpdateContext: resourceSharedFilesystemShareGroupV2Update,
+		DeleteContext: resourceSharedFilesystemShareGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"share_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"share_group_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consistent_snapshot_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareGroupV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	createOpts := sharedFilesystemShareGroupV2CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		ShareTypes:       expandToStringSlice(d.Get("share_types").([]any)),
+		ShareGroupTypeID: d.Get("share_group_type_id").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_v2 create options: %#v", createOpts)
+
+	shareGroup, err := sharedFilesystemShareGroupV2Create(ctx, sfsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_v2: %s", err)
+	}
+
+	d.SetId(shareGroup.ID)
+
+	err = waitForSFV2ShareGroup(ctx, sfsClient, shareGroup.ID, "available", []string{"creating"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSharedFilesystemShareGroupV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	shareGroup, err := sharedFilesystemShareGroupV2Get(ctx, sfsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_group_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_group_v2 %s: %#v", d.Id(), shareGroup)
+
+	d.Set("project_id", shareGroup.ProjectID)
+	d.Set("name", shareGroup.Name)
+	d.Set("description", shareGroup.Description)
+	d.Set("share_types", shareGroup.ShareTypes)
+	d.Set("share_group_type_id", shareGroup.ShareGroupTypeID)
+	d.Set("share_network_id", shareGroup.ShareNetworkID)
+	d.Set("availability_zone", shareGroup.AvailabilityZone)
+	d.Set("status", shareGroup.Status)
+	d.Set("consistent_snapshot_support", shareGroup.ConsistentSnapshotSupport)
+	d.Set("created_at", shareGroup.CreatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareGroupV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	var updateOpts sharedFilesystemShareGroupV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if updateOpts != (sharedFilesystemShareGroupV2UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		err = sharedFilesystemShareGroupV2Update(ctx, sfsClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_share_group_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareGroupV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	err = sharedFilesystemShareGroupV2Delete(ctx, sfsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_group_v2"))
+	}
+
+	err = waitForSFV2ShareGroup(ctx, sfsClient, d.Id(), "deleted", []string{"", "deleting", "available"}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
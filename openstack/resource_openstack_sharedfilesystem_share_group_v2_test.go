@@ -0,0 +1,136 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareGroup_basic(t *testing.T) {
+	var shareGroup sharedFilesystemShareGroupV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists(t.Context(), "openstack_sharedfilesystem_share_group_v2.share_group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "name", "share_group"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "description", "test share group description"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "status", "available"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_v2.share_1", "share_group_id",
+						"openstack_sharedfilesystem_share_group_v2.share_group_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareGroupConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists(t.Context(), "openstack_sharedfilesystem_share_group_v2.share_group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "name", "share_group_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.share_group_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_share_group_v2" {
+				continue
+			}
+
+			_, err := sharedFilesystemShareGroupV2Get(ctx, sfsClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("Manila share group still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareGroupExists(ctx context.Context, n string, shareGroup *sharedFilesystemShareGroupV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		found, err := sharedFilesystemShareGroupV2Get(ctx, sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Share group not found")
+		}
+
+		*shareGroup = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareGroupConfigBasic = `
+resource "openstack_sharedfilesystem_share_group_v2" "share_group_1" {
+  name        = "share_group"
+  description = "test share group description"
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name           = "nfs_share"
+  share_proto    = "NFS"
+  share_type     = "dhss_false"
+  size           = 1
+  share_group_id = openstack_sharedfilesystem_share_group_v2.share_group_1.id
+}
+`
+
+const testAccSFSV2ShareGroupConfigUpdate = `
+resource "openstack_sharedfilesystem_share_group_v2" "share_group_1" {
+  name = "share_group_updated"
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name           = "nfs_share"
+  share_proto    = "NFS"
+  share_type     = "dhss_false"
+  size           = 1
+  share_group_id = openstack_sharedfilesystem_share_group_v2.share_group_1.id
+}
+`
@@ -0,0 +1,273 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/errors"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/replicas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSharedFilesystemShareReplicaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareReplicaV2Create,
+		ReadContext:   resourceSharedFilesystemShareReplicaV2Read,
+		UpdateContext: resourceSharedFilesystemShareReplicaV2Update,
+		DeleteContext: resourceSharedFilesystemShareReplicaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"replica_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active",
+				}, false),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	createOpts := replicas.CreateOpts{
+		ShareID:          d.Get("share_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var replica *replicas.Replica
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		replica, err = replicas.Create(ctx, sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s: %s", err, e)
+		}
+
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(replica.ID)
+
+	err = waitForSFV2ShareReplica(ctx, sfsClient, replica.ID, "available", []string{"creating"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("replica_state").(string) == "active" {
+		if err := resourceSharedFilesystemShareReplicaV2Promote(ctx, d, sfsClient, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	replica, err := replicas.Get(ctx, sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_replica_v2 %s: %#v", d.Id(), replica)
+
+	d.Set("share_id", replica.ShareID)
+	d.Set("availability_zone", replica.AvailabilityZone)
+	d.Set("share_network_id", replica.ShareNetworkID)
+	d.Set("replica_state", replica.State)
+	d.Set("status", replica.Status)
+	d.Set("host", replica.Host)
+	d.Set("share_server_id", replica.ShareServerID)
+	d.Set("created_at", replica.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	if d.HasChange("replica_state") && d.Get("replica_state").(string) == "active" {
+		if err := resourceSharedFilesystemShareReplicaV2Promote(ctx, d, sfsClient, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_share_replica_v2 %s", d.Id())
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err = replicas.Delete(ctx, sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+
+		detailedErr := errors.ErrorDetails{}
+
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_share_replica_v2 %s: %s: %s", d.Id(), err, e)
+		}
+
+		for k, msg := range detailedErr {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_share_replica_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	err = waitForSFV2ShareReplica(ctx, sfsClient, d.Id(), "deleted", []string{"", "deleting", "available"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Promote(ctx context.Context, d *schema.ResourceData, sfsClient *gophercloud.ServiceClient, timeout time.Duration) error {
+	log.Printf("[DEBUG] Attempting to promote openstack_sharedfilesystem_share_replica_v2 %s", d.Id())
+
+	err := replicas.Promote(ctx, sfsClient, d.Id(), replicas.PromoteOpts{}).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error promoting openstack_sharedfilesystem_share_replica_v2 %s: %w", d.Id(), err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{"active"},
+		Pending:    []string{"replication_change", "in_sync", "out_of_sync"},
+		Refresh:    resourceSFV2ShareReplicaStateRefreshFunc(ctx, sfsClient, d.Id()),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for openstack_sharedfilesystem_share_replica_v2 %s to become active: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/replicas"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareReplica_basic(t *testing.T) {
+	var replica replicas.Replica
+
+	stName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigBasic(stName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists(t.Context(), "openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "status", "available"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_replica_v2.replica_1", "share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareReplicaConfigPromote(stName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists(t.Context(), "openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "replica_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareReplicaDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_share_replica_v2" {
+				continue
+			}
+
+			_, err := replicas.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila share replica still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareReplicaExists(ctx context.Context, n string, replica *replicas.Replica) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+		found, err := replicas.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Share replica not found")
+		}
+
+		*replica = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2ShareReplicaConfig(stName string) string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "%s"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    replication_type = "readable"
+  }
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name        = "nfs_share"
+  share_proto = "NFS"
+  share_type  = openstack_sharedfilesystem_share_type_v2.share_type_1.name
+  size        = 1
+}
+`, stName)
+}
+
+func testAccSFSV2ShareReplicaConfigBasic(stName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`, testAccSFSV2ShareReplicaConfig(stName))
+}
+
+func testAccSFSV2ShareReplicaConfigPromote(stName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id      = openstack_sharedfilesystem_share_v2.share_1.id
+  replica_state = "active"
+}
+`, testAccSFSV2ShareReplicaConfig(stName))
+}
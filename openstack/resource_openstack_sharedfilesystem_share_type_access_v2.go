@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareTypeAccessV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeAccessV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeAccessV2Read,
+		DeleteContext: resourceSharedFilesystemShareTypeAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	projectID := d.Get("project_id").(string)
+	stID := d.Get("share_type_id").(string)
+
+	accessOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.AddAccess(ctx, sfsClient, stID, accessOpts).ExtractErr(); err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_type_access_v2: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", stID, projectID)
+	d.SetId(id)
+
+	return resourceSharedFilesystemShareTypeAccessV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	stID, projectID, err := parsePairedIDs(d.Id(), "openstack_sharedfilesystem_share_type_access_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allAccesses, err := sharetypes.ShowAccess(ctx, sfsClient, stID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_type_access_v2"))
+	}
+
+	found := false
+
+	for _, access := range allAccesses {
+		if access.ShareTypeID == stID && access.ProjectID == projectID {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		return diag.Errorf("Error getting share type access openstack_sharedfilesystem_share_type_access_v2 for st: %s", stID)
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("project_id", projectID)
+	d.Set("share_type_id", stID)
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	stID, projectID, err := parsePairedIDs(d.Id(), "openstack_sharedfilesystem_share_type_access_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	removeOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.RemoveAccess(ctx, sfsClient, stID, removeOpts).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error removing openstack_sharedfilesystem_share_type_access_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareTypeAccess_basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := "ACCPTTEST-" + acctest.RandString(5)
+	stName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeAccessDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeAccessBasic(projectName, stName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists(t.Context(), "openstack_identity_project_v3.project_1", &project),
+					testAccCheckSFSV2ShareTypeAccessExists(t.Context(), "openstack_sharedfilesystem_share_type_access_v2.share_type_access"),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_sharedfilesystem_share_type_access_v2.share_type_access", "project_id", &project.ID),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_type_access_v2.share_type_access", "share_type_id",
+						"openstack_sharedfilesystem_share_type_v2.share_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareTypeAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_share_type_access_v2" {
+				continue
+			}
+
+			stID, projectID, err := parsePairedIDs(rs.Primary.ID, "openstack_sharedfilesystem_share_type_access_v2")
+			if err != nil {
+				return err
+			}
+
+			allAccesses, err := sharetypes.ShowAccess(ctx, sfsClient, stID).Extract()
+			if err == nil {
+				for _, access := range allAccesses {
+					if access.ShareTypeID == stID && access.ProjectID == projectID {
+						return errors.New("Share type access still exists")
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareTypeAccessExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		stID, projectID, err := parsePairedIDs(rs.Primary.ID, "openstack_sharedfilesystem_share_type_access_v2")
+		if err != nil {
+			return err
+		}
+
+		allAccesses, err := sharetypes.ShowAccess(ctx, sfsClient, stID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving accesses for share type: %s", stID)
+		}
+
+		for _, access := range allAccesses {
+			if access.ShareTypeID == stID && access.ProjectID == projectID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Share type access not found: %s", rs.Primary.ID)
+	}
+}
+
+func testAccSFSV2ShareTypeAccessBasic(projectName, stName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "%s"
+  is_public                    = false
+  driver_handles_share_servers = false
+}
+
+resource "openstack_sharedfilesystem_share_type_access_v2" "share_type_access" {
+  project_id    = openstack_identity_project_v3.project_1.id
+  share_type_id = openstack_sharedfilesystem_share_type_v2.share_type_1.id
+}
+`, projectName, stName)
+}
@@ -0,0 +1,194 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareTypeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeV2Read,
+		UpdateContext: resourceSharedFilesystemShareTypeV2Update,
+		DeleteContext: resourceSharedFilesystemShareTypeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"driver_handles_share_servers": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	name := d.Get("name").(string)
+	createOpts := sharetypes.CreateOpts{
+		Name:     name,
+		IsPublic: d.Get("is_public").(bool),
+		ExtraSpecs: sharetypes.ExtraSpecsOpts{
+			DriverHandlesShareServers: d.Get("driver_handles_share_servers").(bool),
+		},
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_type_v2 create options: %#v", createOpts)
+
+	st, err := sharetypes.Create(ctx, sfsClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_type_v2 %s: %s", name, err)
+	}
+
+	d.SetId(st.ID)
+
+	if v, ok := d.GetOk("extra_specs"); ok {
+		setOpts := sharetypes.SetExtraSpecsOpts{
+			ExtraSpecs: v.(map[string]any),
+		}
+
+		_, err = sharetypes.SetExtraSpecs(ctx, sfsClient, st.ID, setOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error setting extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", st.ID, err)
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	st, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_type_v2 %s: %#v", d.Id(), st)
+
+	d.Set("name", st.Name)
+	d.Set("is_public", st.IsPublic)
+	d.Set("region", GetRegion(d, config))
+
+	if v, ok := st.ExtraSpecs["driver_handles_share_servers"].(string); ok {
+		dhss, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse driver_handles_share_servers for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+		}
+
+		d.Set("driver_handles_share_servers", dhss)
+	}
+
+	if err := d.Set("extra_specs", flattenSharedFilesystemShareTypeV2ExtraSpecs(st.ExtraSpecs)); err != nil {
+		log.Printf("[WARN] Unable to set extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeV2Update(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	if d.HasChange("extra_specs") {
+		oldES, newES := d.GetChange("extra_specs")
+		newESRaw := newES.(map[string]any)
+
+		// Unset the extra specs which are not configured anymore.
+		for oldKey := range oldES.(map[string]any) {
+			if _, ok := newESRaw[oldKey]; ok {
+				continue
+			}
+
+			if err := sharetypes.UnsetExtraSpecs(ctx, sfsClient, d.Id(), oldKey).ExtractErr(); err != nil {
+				return diag.Errorf("Error unsetting extra_spec %s from openstack_sharedfilesystem_share_type_v2 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+
+		if len(newESRaw) > 0 {
+			setOpts := sharetypes.SetExtraSpecsOpts{
+				ExtraSpecs: newESRaw,
+			}
+
+			_, err = sharetypes.SetExtraSpecs(ctx, sfsClient, d.Id(), setOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error setting extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	err = sharetypes.Delete(ctx, sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	return nil
+}
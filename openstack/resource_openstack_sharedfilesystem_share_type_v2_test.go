@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareType_basic(t *testing.T) {
+	var shareType sharetypes.ShareType
+
+	stName := "ACCPTTEST-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeConfigBasic(stName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists(t.Context(), "openstack_sharedfilesystem_share_type_v2.share_type_1", &shareType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "name", stName),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "driver_handles_share_servers", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.snapshot_support", "True"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareTypeConfigUpdate(stName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists(t.Context(), "openstack_sharedfilesystem_share_type_v2.share_type_1", &shareType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.create_share_from_snapshot_support", "True"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_share_type_v2" {
+				continue
+			}
+
+			_, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("Manila share type still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareTypeExists(ctx context.Context, n string, shareType *sharetypes.ShareType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		found, err := sharedFilesystemShareTypeV2Get(ctx, sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Share type not found")
+		}
+
+		*shareType = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2ShareTypeConfigBasic(stName string) string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "%s"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    snapshot_support = "True"
+  }
+}
+`, stName)
+}
+
+func testAccSFSV2ShareTypeConfigUpdate(stName string) string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "%s"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    create_share_from_snapshot_support = "True"
+  }
+}
+`, stName)
+}
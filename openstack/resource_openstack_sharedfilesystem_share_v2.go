@@ -90,6 +90,18 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"share_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"revert_to_snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"is_public": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -197,6 +209,11 @@ func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.Resour
 		createOpts.ShareType = v.(string)
 	}
 
+	if v, ok := d.GetOk("share_group_id"); ok {
+		bumpClientMicroversion(sfsClient, sharedFilesystemV2ShareGroupIDMicroversion)
+		createOpts.ShareGroupID = v.(string)
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
@@ -247,6 +264,11 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 
 	sfsClient.Microversion = minManilaShareMicroversion
 
+	// The share group is only returned starting with microversion 2.31.
+	if _, ok := d.GetOk("share_group_id"); ok {
+		bumpClientMicroversion(sfsClient, sharedFilesystemV2ShareGroupIDMicroversion)
+	}
+
 	share, err := shares.Get(ctx, sfsClient, d.Id()).Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, "share"))
@@ -283,6 +305,7 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 	d.Set("all_metadata", share.Metadata)
 	d.Set("share_network_id", share.ShareNetworkID)
 	d.Set("availability_zone", share.AvailabilityZone)
+	d.Set("share_group_id", share.ShareGroupID)
 	// Computed
 	d.Set("region", GetRegion(d, config))
 	d.Set("project_id", share.ProjectID)
@@ -360,6 +383,40 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if v := d.Get("revert_to_snapshot_id").(string); d.HasChange("revert_to_snapshot_id") && v != "" {
+		bumpClientMicroversion(sfsClient, sharedFilesystemV2ShareRevertMicroversion)
+
+		revertOpts := shares.RevertOpts{SnapshotID: v}
+		log.Printf("[DEBUG] Reverting share %s with options: %#v", d.Id(), revertOpts)
+
+		err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			err := shares.Revert(ctx, sfsClient, d.Id(), revertOpts).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			detailedErr := errors.ErrorDetails{}
+
+			e := errors.ExtractErrorInto(err, &detailedErr)
+			if e != nil {
+				return diag.Errorf("Unable to revert %s share to snapshot %s: %s: %s", d.Id(), v, err, e)
+			}
+
+			for k, msg := range detailedErr {
+				return diag.Errorf("Unable to revert %s share to snapshot %s: %s (%d): %s", d.Id(), v, k, msg.Code, msg.Message)
+			}
+		}
+
+		// Wait for share to become active before continuing
+		err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", []string{"reverting"}, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("size") {
 		var pending []string
 
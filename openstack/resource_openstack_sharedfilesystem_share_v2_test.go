@@ -153,6 +153,36 @@ func TestAccSFSV2Share_admin(t *testing.T) {
 	})
 }
 
+func TestAccSFSV2Share_revert(t *testing.T) {
+	var share shares.Share
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareConfigRevert(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists(t.Context(), "openstack_sharedfilesystem_share_v2.share_1", &share),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareConfigRevert(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists(t.Context(), "openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_v2.share_1", "revert_to_snapshot_id",
+						"data.openstack_sharedfilesystem_snapshot_v2.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSFSV2ShareDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -360,3 +390,36 @@ resource "openstack_sharedfilesystem_share_v2" "share_1" {
   size             = 1
 }
 `
+
+func testAccSFSV2ShareConfigRevert(revert bool) string {
+	snapshotID := "null"
+	snapshotData := ""
+
+	// The share can't reference the managed snapshot directly, because the
+	// snapshot already depends on the share.
+	if revert {
+		snapshotID = "data.openstack_sharedfilesystem_snapshot_v2.snapshot_1.id"
+		snapshotData = `
+data "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name = "nfs_snapshot_revert"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name                  = "nfs_share"
+  share_proto           = "NFS"
+  share_type            = "dhss_false"
+  size                  = 1
+  revert_to_snapshot_id = %s
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name     = "nfs_snapshot_revert"
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`, snapshotData, snapshotID)
+}
@@ -0,0 +1,185 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareNetworkSubnetV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareNetworkSubnetV2Create,
+		ReadContext:   resourceSharedFilesystemShareNetworkSubnetV2Read,
+		DeleteContext: resourceSharedFilesystemShareNetworkSubnetV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"neutron_net_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"neutron_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"network_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"segmentation_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mtu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareNetworkSubnetV2Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareNetworkSubnetMicroversion
+
+	shareNetworkID := d.Get("share_network_id").(string)
+	createOpts := sharedFilesystemShareNetworkSubnetV2CreateOpts{
+		NeutronNetID:     d.Get("neutron_net_id").(string),
+		NeutronSubnetID:  d.Get("neutron_subnet_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_sharenetwork_subnet_v2 create options: %#v", createOpts)
+
+	subnet, err := sharedFilesystemShareNetworkSubnetV2Create(ctx, sfsClient, shareNetworkID, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_sharenetwork_subnet_v2: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", shareNetworkID, subnet.ID))
+
+	return resourceSharedFilesystemShareNetworkSubnetV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareNetworkSubnetV2Read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareNetworkSubnetMicroversion
+
+	shareNetworkID, subnetID, err := parsePairedIDs(d.Id(), "openstack_sharedfilesystem_sharenetwork_subnet_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnet, err := sharedFilesystemShareNetworkSubnetV2Get(ctx, sfsClient, shareNetworkID, subnetID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_sharenetwork_subnet_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_sharenetwork_subnet_v2 %s: %#v", d.Id(), subnet)
+
+	// The default subnet of a share network has no availability zone.
+	var availabilityZone string
+	if subnet.AvailabilityZone != nil {
+		availabilityZone = *subnet.AvailabilityZone
+	}
+
+	d.Set("share_network_id", shareNetworkID)
+	d.Set("neutron_net_id", subnet.NeutronNetID)
+	d.Set("neutron_subnet_id", subnet.NeutronSubnetID)
+	d.Set("availability_zone", availabilityZone)
+	d.Set("network_type", subnet.NetworkType)
+	d.Set("segmentation_id", subnet.SegmentationID)
+	d.Set("cidr", subnet.CIDR)
+	d.Set("ip_version", subnet.IPVersion)
+	d.Set("gateway", subnet.Gateway)
+	d.Set("mtu", subnet.MTU)
+	d.Set("created_at", subnet.CreatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareNetworkSubnetV2Delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*Config)
+
+	sfsClient, err := config.SharedfilesystemV2Client(ctx, GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareNetworkSubnetMicroversion
+
+	shareNetworkID, subnetID, err := parsePairedIDs(d.Id(), "openstack_sharedfilesystem_sharenetwork_subnet_v2")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = sharedFilesystemShareNetworkSubnetV2Delete(ctx, sfsClient, shareNetworkID, subnetID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_sharenetwork_subnet_v2"))
+	}
+
+	return nil
+}
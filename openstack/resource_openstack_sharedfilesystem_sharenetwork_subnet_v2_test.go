@@ -0,0 +1,146 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2ShareNetworkSubnet_basic(t *testing.T) {
+	var subnet sharedFilesystemShareNetworkSubnetV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareNetworkSubnetDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareNetworkSubnetConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareNetworkSubnetExists(t.Context(), "openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", "share_network_id",
+						"openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "id"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", "neutron_net_id",
+						"openstack_networking_network_v2.network_2", "id"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", "neutron_subnet_id",
+						"openstack_networking_subnet_v2.subnet_2", "id"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", "availability_zone",
+						"data.openstack_sharedfilesystem_availability_zones_v2.zones", "names.0"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_subnet_v2.subnet_1", "cidr", "192.168.198.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareNetworkSubnetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareNetworkSubnetMicroversion
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_sharenetwork_subnet_v2" {
+				continue
+			}
+
+			shareNetworkID, subnetID, err := parsePairedIDs(rs.Primary.ID, "openstack_sharedfilesystem_sharenetwork_subnet_v2")
+			if err != nil {
+				return err
+			}
+
+			_, err = sharedFilesystemShareNetworkSubnetV2Get(ctx, sfsClient, shareNetworkID, subnetID)
+			if err == nil {
+				return fmt.Errorf("Manila sharenetwork subnet still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2ShareNetworkSubnetExists(ctx context.Context, n string, subnet *sharedFilesystemShareNetworkSubnetV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareNetworkSubnetMicroversion
+
+		shareNetworkID, subnetID, err := parsePairedIDs(rs.Primary.ID, "openstack_sharedfilesystem_sharenetwork_subnet_v2")
+		if err != nil {
+			return err
+		}
+
+		found, err := sharedFilesystemShareNetworkSubnetV2Get(ctx, sfsClient, shareNetworkID, subnetID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != subnetID {
+			return errors.New("Sharenetwork subnet not found")
+		}
+
+		*subnet = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2ShareNetworkSubnetConfigBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_networking_network_v2" "network_2" {
+  name = "network_2"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_2" {
+  name = "subnet_2"
+  cidr = "192.168.198.0/24"
+  ip_version = 4
+  network_id = openstack_networking_network_v2.network_2.id
+}
+
+data "openstack_sharedfilesystem_availability_zones_v2" "zones" {}
+
+resource "openstack_sharedfilesystem_sharenetwork_v2" "sharenetwork_1" {
+  name                = "test_sharenetwork"
+  neutron_net_id      = openstack_networking_network_v2.network_1.id
+  neutron_subnet_id   = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_sharedfilesystem_sharenetwork_subnet_v2" "subnet_1" {
+  share_network_id  = openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1.id
+  neutron_net_id    = openstack_networking_network_v2.network_2.id
+  neutron_subnet_id = openstack_networking_subnet_v2.subnet_2.id
+  availability_zone = data.openstack_sharedfilesystem_availability_zones_v2.zones.names[0]
+}
+`, testAccSFSV2ShareNetworkConfig)
+}
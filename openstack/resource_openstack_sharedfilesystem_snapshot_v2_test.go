@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSFSV2Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2SnapshotDestroy(t.Context()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2SnapshotConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists(t.Context(), "openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "nfs_snapshot"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "description", "test snapshot description"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_size", "1"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2SnapshotConfigUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists(t.Context(), "openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "nfs_snapshot_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2SnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openstack_sharedfilesystem_snapshot_v2" {
+				continue
+			}
+
+			_, err := snapshots.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+			if err == nil {
+				return fmt.Errorf("Manila snapshot still exists: %s", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSFSV2SnapshotExists(ctx context.Context, n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		sfsClient, err := config.SharedfilesystemV2Client(ctx, osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %w", err)
+		}
+
+		found, err := snapshots.Get(ctx, sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return errors.New("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+func testAccSFSV2SnapshotConfigBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name        = "nfs_snapshot"
+  description = "test snapshot description"
+  share_id    = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`, testAccSFSV2ShareConfigBasic)
+}
+
+func testAccSFSV2SnapshotConfigUpdate() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name     = "nfs_snapshot_updated"
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`, testAccSFSV2ShareConfigBasic)
+}
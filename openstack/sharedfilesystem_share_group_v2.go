@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Share groups are not available in gophercloud yet, so the requests below
+// are issued against the Shared File Systems API directly.
+
+// sharedFilesystemShareGroupV2 represents a Shared File Systems share group.
+type sharedFilesystemShareGroupV2 struct {
+	ID                        string   `json:"id"`
+	Name                      string   `json:"name"`
+	Description               string   `json:"description"`
+	Status                    string   `json:"status"`
+	ProjectID                 string   `json:"project_id"`
+	ShareTypes                []string `json:"share_types"`
+	ShareGroupTypeID          string   `json:"share_group_type_id"`
+	ShareNetworkID            string   `json:"share_network_id"`
+	AvailabilityZone          string   `json:"availability_zone"`
+	ConsistentSnapshotSupport string   `json:"consistent_snapshot_support"`
+	CreatedAt                 string   `json:"created_at"`
+}
+
+// sharedFilesystemShareGroupV2CreateOpts represents the attributes used when
+// creating a new share group.
+type sharedFilesystemShareGroupV2CreateOpts struct {
+	Name             string   `json:"name,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	ShareTypes       []string `json:"share_types,omitempty"`
+	ShareGroupTypeID string   `json:"share_group_type_id,omitempty"`
+	ShareNetworkID   string   `json:"share_network_id,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+}
+
+// sharedFilesystemShareGroupV2UpdateOpts represents the attributes used when
+// updating an existing share group.
+type sharedFilesystemShareGroupV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func sharedFilesystemShareGroupV2Create(ctx context.Context, client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupV2CreateOpts) (*sharedFilesystemShareGroupV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		ShareGroup sharedFilesystemShareGroupV2 `json:"share_group"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("share-groups"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.ShareGroup, nil
+}
+
+func sharedFilesystemShareGroupV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*sharedFilesystemShareGroupV2, error) {
+	var r struct {
+		ShareGroup sharedFilesystemShareGroupV2 `json:"share_group"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("share-groups", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.ShareGroup, nil
+}
+
+func sharedFilesystemShareGroupV2Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareGroupV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, client.ServiceURL("share-groups", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareGroupV2Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("share-groups", id), nil)
+
+	return err
+}
+
+// Full list of the share group statuses: https://docs.openstack.org/api-ref/shared-file-system/#share-groups-since-api-v2-31
+func waitForSFV2ShareGroup(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share group %s to become %s.", id, target)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceSFV2ShareGroupRefreshFunc(ctx, sfsClient, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: share group %s not found: %w", id, err)
+			}
+		}
+
+		errorMessage := fmt.Sprintf("Error waiting for share group %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(ctx, sfsClient, id)
+
+		if msg == nil {
+			return fmt.Errorf("%s: %w", errorMessage, err)
+		}
+
+		return fmt.Errorf("%s: %w: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+func resourceSFV2ShareGroupRefreshFunc(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		shareGroup, err := sharedFilesystemShareGroupV2Get(ctx, sfsClient, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return shareGroup, shareGroup.Status, nil
+	}
+}
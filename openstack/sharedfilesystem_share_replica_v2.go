@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/replicas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Full list of the replica statuses: https://docs.openstack.org/api-ref/shared-file-system/#share-replicas-since-api-v2-11
+func waitForSFV2ShareReplica(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share replica %s to become %s.", id, target)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceSFV2ShareReplicaRefreshFunc(ctx, sfsClient, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: share replica %s not found: %w", id, err)
+			}
+		}
+
+		errorMessage := fmt.Sprintf("Error waiting for share replica %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(ctx, sfsClient, id)
+
+		if msg == nil {
+			return fmt.Errorf("%s: %w", errorMessage, err)
+		}
+
+		return fmt.Errorf("%s: %w: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+func resourceSFV2ShareReplicaRefreshFunc(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		replica, err := replicas.Get(ctx, sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return replica, replica.Status, nil
+	}
+}
+
+// resourceSFV2ShareReplicaStateRefreshFunc reports the replica_state of a
+// share replica instead of its status.
+func resourceSFV2ShareReplicaStateRefreshFunc(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		replica, err := replicas.Get(ctx, sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if replica.Status == "error" {
+			return replica, replica.Status, fmt.Errorf("The share replica %s is in error status", id)
+		}
+
+		// The replica_state is updated before the status goes back to
+		// available.
+		if replica.Status != "available" {
+			return replica, replica.Status, nil
+		}
+
+		return replica, replica.State, nil
+	}
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+// sharedFilesystemShareTypeV2Get retrieves a single share type. gophercloud
+// only supports listing share types.
+func sharedFilesystemShareTypeV2Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (*sharetypes.ShareType, error) {
+	var r struct {
+		ShareType sharetypes.ShareType `json:"share_type"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("types", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.ShareType, nil
+}
+
+// flattenSharedFilesystemShareTypeV2ExtraSpecs returns the optional extra
+// specs of a share type. The required driver_handles_share_servers extra
+// spec is exposed as a dedicated argument.
+func flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs map[string]any) map[string]string {
+	specs := make(map[string]string, len(extraSpecs))
+
+	for k, v := range extraSpecs {
+		if k == "driver_handles_share_servers" {
+			continue
+		}
+
+		if s, ok := v.(string); ok {
+			specs[k] = s
+		}
+	}
+
+	return specs
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Share network subnets are not available in gophercloud yet, so the
+// requests below are issued against the Shared File Systems API directly.
+
+// sharedFilesystemShareNetworkSubnetV2 represents a subnet of a Shared File
+// Systems share network.
+type sharedFilesystemShareNetworkSubnetV2 struct {
+	ID               string  `json:"id"`
+	ShareNetworkID   string  `json:"share_network_id"`
+	NeutronNetID     string  `json:"neutron_net_id"`
+	NeutronSubnetID  string  `json:"neutron_subnet_id"`
+	AvailabilityZone *string `json:"availability_zone"`
+	NetworkType      string  `json:"network_type"`
+	SegmentationID   int     `json:"segmentation_id"`
+	CIDR             string  `json:"cidr"`
+	IPVersion        int     `json:"ip_version"`
+	Gateway          string  `json:"gateway"`
+	MTU              int     `json:"mtu"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+// sharedFilesystemShareNetworkSubnetV2CreateOpts represents the attributes
+// used when creating a new share network subnet.
+type sharedFilesystemShareNetworkSubnetV2CreateOpts struct {
+	NeutronNetID     string `json:"neutron_net_id" required:"true"`
+	NeutronSubnetID  string `json:"neutron_subnet_id" required:"true"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+func sharedFilesystemShareNetworkSubnetV2Create(ctx context.Context, client *gophercloud.ServiceClient, shareNetworkID string, opts sharedFilesystemShareNetworkSubnetV2CreateOpts) (*sharedFilesystemShareNetworkSubnetV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share-network-subnet")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Subnet sharedFilesystemShareNetworkSubnetV2 `json:"share_network_subnet"`
+	}
+
+	_, err = client.Post(ctx, client.ServiceURL("share-networks", shareNetworkID, "subnets"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Subnet, nil
+}
+
+func sharedFilesystemShareNetworkSubnetV2Get(ctx context.Context, client *gophercloud.ServiceClient, shareNetworkID, id string) (*sharedFilesystemShareNetworkSubnetV2, error) {
+	var r struct {
+		Subnet sharedFilesystemShareNetworkSubnetV2 `json:"share_network_subnet"`
+	}
+
+	_, err := client.Get(ctx, client.ServiceURL("share-networks", shareNetworkID, "subnets", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Subnet, nil
+}
+
+func sharedFilesystemShareNetworkSubnetV2Delete(ctx context.Context, client *gophercloud.ServiceClient, shareNetworkID, id string) error {
+	_, err := client.Delete(ctx, client.ServiceURL("share-networks", shareNetworkID, "subnets", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Full list of the snapshot statuses: https://docs.openstack.org/api-ref/shared-file-system/#share-snapshots
+func waitForSFV2Snapshot(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for snapshot %s to become %s.", id, target)
+
+	stateConf := &retry.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceSFV2SnapshotRefreshFunc(ctx, sfsClient, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: snapshot %s not found: %w", id, err)
+			}
+		}
+
+		errorMessage := fmt.Sprintf("Error waiting for snapshot %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(ctx, sfsClient, id)
+
+		if msg == nil {
+			return fmt.Errorf("%s: %w", errorMessage, err)
+		}
+
+		return fmt.Errorf("%s: %w: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+func resourceSFV2SnapshotRefreshFunc(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		snapshot, err := snapshots.Get(ctx, sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, snapshot.Status, nil
+	}
+}
@@ -303,6 +303,23 @@ func validateJSONObject(v any, k string) ([]string, []error) {
 	return nil, nil
 }
 
+func validateJSONArray(v any, k string) ([]string, []error) {
+	if v == nil || v.(string) == "" {
+		return nil, []error{fmt.Errorf("%q value must not be empty", k)}
+	}
+
+	var j []any
+
+	s := v.(string)
+
+	err := json.Unmarshal([]byte(s), &j)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON array: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
 func diffSuppressJSONObject(_, o, n string, _ *schema.ResourceData) bool {
 	if strSliceContains([]string{"{}", ""}, o) &&
 		strSliceContains([]string{"{}", ""}, n) {
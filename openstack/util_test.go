@@ -117,6 +117,17 @@ func TestUnitparsePairedIDs(t *testing.T) {
 	assert.Equal(t, expectedChildID, actualChildID)
 }
 
+func TestUnitValidateJSONArray(t *testing.T) {
+	_, errs := validateJSONArray(`[{"local": [], "remote": []}]`, "rules")
+	assert.Empty(t, errs)
+
+	_, errs = validateJSONArray(`{"local": [], "remote": []}`, "rules")
+	assert.Len(t, errs, 1)
+
+	_, errs = validateJSONArray("", "rules")
+	assert.Len(t, errs, 1)
+}
+
 func TestUnitStringSliceToSet(t *testing.T) {
 	tests := []struct {
 		name     string